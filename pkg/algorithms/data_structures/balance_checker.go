@@ -0,0 +1,764 @@
+package data_structures
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxExactFixTokens is the largest number of delimiters for which SuggestFix computes a provably minimal fix.
+//
+// The exact fix is an O(n^3) dynamic program over delimiters; above this limit a greedy
+// stack-based repair is used instead, which is always valid but not always minimal.
+const MaxExactFixTokens = 256
+
+// DelimiterPair describes an opening and a closing delimiter, e.g. "(" and ")" or "{{" and "}}".
+//
+// If Open and Close are equal (e.g. "|" or "**"), the delimiter closes the innermost open
+// pair of the same kind and opens a new one otherwise.
+type DelimiterPair struct {
+	Open  string
+	Close string
+}
+
+// DefaultDelimiterPairs returns the pairs (), [] and {}.
+func DefaultDelimiterPairs() []DelimiterPair {
+	return []DelimiterPair{{"(", ")"}, {"[", "]"}, {"{", "}"}}
+}
+
+// Position identifies a place in the source text.
+//
+// Fields:
+//   - Offset: the zero-based byte offset;
+//   - Line: the one-based line number;
+//   - Column: the one-based column, counted in runes.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// String formats the position as "line L, column C".
+func (p Position) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// BalanceIssueKind classifies a balance problem.
+type BalanceIssueKind int
+
+const (
+	// IssueUnexpectedClose is a closing delimiter while nothing is open.
+	IssueUnexpectedClose BalanceIssueKind = iota
+	// IssueMismatchedClose is a closing delimiter that does not match the innermost open delimiter.
+	IssueMismatchedClose
+	// IssueUnclosed is an opening delimiter that is never closed.
+	IssueUnclosed
+	// IssueUnterminatedQuote is a quote that is never closed.
+	IssueUnterminatedQuote
+)
+
+// BalanceIssue describes a single balance problem.
+//
+// Fields:
+//   - Kind: the kind of the problem;
+//   - Position: where the offending delimiter or quote starts;
+//   - Found: the offending delimiter or quote;
+//   - Expected: the delimiter that was expected instead, empty if nothing was expected;
+//   - OpenedAt: for mismatches and unclosed delimiters, where the innermost relevant delimiter was opened.
+type BalanceIssue struct {
+	Kind     BalanceIssueKind
+	Position Position
+	Found    string
+	Expected string
+	OpenedAt Position
+}
+
+// String returns a human readable description of the issue.
+func (i BalanceIssue) String() string {
+	switch i.Kind {
+	case IssueUnexpectedClose:
+		return fmt.Sprintf("%s: unexpected %q, no delimiter is open", i.Position, i.Found)
+	case IssueMismatchedClose:
+		return fmt.Sprintf("%s: found %q but expected %q to close delimiter opened at %s", i.Position, i.Found, i.Expected, i.OpenedAt)
+	case IssueUnclosed:
+		return fmt.Sprintf("%s: %q is never closed, expected %q", i.Position, i.Found, i.Expected)
+	default:
+		return fmt.Sprintf("%s: quote %q is never closed", i.Position, i.Found)
+	}
+}
+
+// Insertion describes text to be inserted into the source to fix it.
+//
+// Fields:
+//   - Position: where the text is inserted, in the original source;
+//   - Text: the inserted text.
+type Insertion struct {
+	Position Position
+	Text     string
+}
+
+// BalanceChecker validates nesting of delimiters in text using a stack.
+//
+// Fields:
+//   - Pairs: the delimiter pairs to check; longer delimiters take precedence over their prefixes;
+//   - Quotes: quote strings; text between a quote and the next unescaped occurrence of the same quote is ignored;
+//   - Escape: a string that makes the following character literal, empty to disable escaping.
+type BalanceChecker struct {
+	Pairs  []DelimiterPair
+	Quotes []string
+	Escape string
+}
+
+// NewBalanceChecker creates a new BalanceChecker with backslash escaping and no quotes.
+//
+// Parameters:
+//   - pairs: the delimiter pairs to check; DefaultDelimiterPairs is used if none are given.
+//
+// Returns a pointer to the new BalanceChecker.
+func NewBalanceChecker(pairs ...DelimiterPair) *BalanceChecker {
+	if len(pairs) == 0 {
+		pairs = DefaultDelimiterPairs()
+	}
+
+	return &BalanceChecker{Pairs: pairs, Escape: `\`}
+}
+
+// delimiterToken is a delimiter found in the source.
+//
+// Fields:
+//   - pair: the index of the pair in BalanceChecker.Pairs;
+//   - open: whether the token opens the pair;
+//   - start, end: the byte range of the token in the source.
+type delimiterToken struct {
+	pair  int
+	open  bool
+	start int
+	end   int
+}
+
+// scanResult holds the outcome of a single pass over the source.
+//
+// Fields:
+//   - tokens: every delimiter outside quotes, in source order;
+//   - issues: the problems found by the stack matching;
+//   - openQuote: the unterminated quote, empty if all quotes are closed;
+//   - danglingEscape: whether the source ends with an escape that has nothing to make literal;
+//   - stops: for every offset of the source, whether the scan stood there, i.e. the offset is outside
+//     quotes, escapes and delimiters, so text inserted there is scanned on its own.
+type scanResult struct {
+	tokens         []delimiterToken
+	issues         []BalanceIssue
+	openQuote      string
+	danglingEscape bool
+	stops          []bool
+}
+
+// IsBalanced reports whether the source has no balance issues.
+func (bc *BalanceChecker) IsBalanced(src string) bool {
+	return len(bc.Check(src)) == 0
+}
+
+// Check reports every balance issue in the source, ordered by position.
+//
+// After a mismatched closing delimiter the checker recovers: if the delimiter closes a pair
+// opened further down the stack, the pairs above it are dropped; otherwise it is ignored.
+//
+// Parameters:
+//   - src: the text to check.
+//
+// Returns the issues found, nil if the text is balanced.
+func (bc *BalanceChecker) Check(src string) []BalanceIssue {
+	return bc.scan(src).issues
+}
+
+// SuggestFix returns the source with the fewest inserted delimiters that make it balanced.
+//
+// A balanced source is returned unchanged. Otherwise a trailing escape would make the first inserted
+// character literal, so it is escaped itself by appending the first character of the escape, e.g. "(\"
+// becomes "(\\)". An unterminated quote is then closed at the end of the source. Unmatched closing delimiters
+// get their opening delimiter at the start of the enclosing group and unmatched opening delimiters get their
+// closing delimiter at its end, e.g. "a + b)" becomes "(a + b)".
+//
+// An inserted delimiter must not run into its neighbours, e.g. "}}" inserted after "}" reads as "}}" and "}".
+// Such a delimiter is moved as little as possible within its group, and if no place in the group keeps the
+// neighbouring delimiters intact, it is separated from them by a space: "{{ x }" becomes "{{ {x } }}".
+// The fixed text is checked before it is returned; if the minimal fix is not balanced, the greedy one is used.
+//
+// Parameters:
+//   - src: the text to fix.
+//
+// Returns the fixed text and the insertions in source order, nil if the text is already balanced.
+func (bc *BalanceChecker) SuggestFix(src string) (string, []Insertion) {
+	result := bc.scan(src)
+	if len(result.issues) == 0 {
+		return src, nil
+	}
+
+	text := src
+	var insertions []Insertion
+
+	if result.danglingEscape {
+		_, size := utf8.DecodeRuneInString(bc.Escape)
+		text += bc.Escape[:size]
+		insertions = append(insertions, Insertion{Position: Position{Offset: len(src)}, Text: bc.Escape[:size]})
+	}
+
+	if result.openQuote != "" {
+		text += result.openQuote
+		insertions = append(insertions, Insertion{Position: Position{Offset: len(src)}, Text: result.openQuote})
+	}
+
+	// The closed quote and the escaped escape hold no delimiters, and the scan stands only at the end of them.
+	stops := append(result.stops, make([]bool, len(text)-len(src))...)
+	fixer := newBalanceFixer(bc, text, result.tokens, append(stops, true))
+
+	var fixed string
+	if len(fixer.tokens) <= MaxExactFixTokens {
+		fixer.solve()
+		fixed = fixer.fix()
+	}
+
+	if fixer.cost == nil || !bc.IsBalanced(fixed) {
+		fixer.cost = nil
+		fixed = fixer.fix()
+	}
+
+	for _, ins := range fixer.insertions {
+		ins.Position.Offset = min(ins.Position.Offset, len(src))
+		insertions = append(insertions, ins)
+	}
+
+	sort.SliceStable(insertions, func(a, b int) bool {
+		return insertions[a].Position.Offset < insertions[b].Position.Offset
+	})
+
+	lines := lineStarts(src)
+	for i := range insertions {
+		insertions[i].Position = positionAt(src, lines, insertions[i].Position.Offset)
+	}
+
+	return fixed, insertions
+}
+
+// scan walks the source once, collecting delimiters and matching them with a stack.
+func (bc *BalanceChecker) scan(src string) scanResult {
+	result := scanResult{stops: make([]bool, len(src))}
+	lines := lineStarts(src)
+	stack := NewStack[delimiterToken](0)
+
+	for i := 0; i < len(src); {
+		result.stops[i] = true
+
+		if bc.Escape != "" && strings.HasPrefix(src[i:], bc.Escape) {
+			i, result.danglingEscape = bc.skipEscape(src, i)
+			continue
+		}
+
+		if quote := longestPrefix(src[i:], bc.Quotes); quote != "" {
+			end, closed, dangling := bc.skipQuoted(src, i+len(quote), quote)
+			result.danglingEscape = dangling
+
+			if !closed {
+				result.openQuote = quote
+				result.issues = append(result.issues, BalanceIssue{
+					Kind:     IssueUnterminatedQuote,
+					Position: positionAt(src, lines, i),
+					Found:    quote,
+					Expected: quote,
+				})
+			}
+
+			i = end
+
+			continue
+		}
+
+		token, ok := bc.matchDelimiter(src, i, stack)
+		if !ok {
+			_, size := utf8.DecodeRuneInString(src[i:])
+			i += size
+
+			continue
+		}
+
+		result.tokens = append(result.tokens, token)
+		i = token.end
+
+		if token.open {
+			stack.Push(token)
+			continue
+		}
+
+		result.issues = append(result.issues, bc.closeToken(src, lines, stack, token)...)
+	}
+
+	for !stack.IsEmpty() {
+		open, _ := stack.Pop()
+		position := positionAt(src, lines, open.start)
+
+		result.issues = append(result.issues, BalanceIssue{
+			Kind:     IssueUnclosed,
+			Position: position,
+			Found:    bc.Pairs[open.pair].Open,
+			Expected: bc.Pairs[open.pair].Close,
+			OpenedAt: position,
+		})
+	}
+
+	sort.SliceStable(result.issues, func(a, b int) bool {
+		return result.issues[a].Position.Offset < result.issues[b].Position.Offset
+	})
+
+	return result
+}
+
+// closeToken matches a closing delimiter against the stack and returns the issues it causes.
+func (bc *BalanceChecker) closeToken(src string, lines []int, stack *Stack[delimiterToken], token delimiterToken) []BalanceIssue {
+	position := positionAt(src, lines, token.start)
+	found := bc.Pairs[token.pair].Close
+
+	top, err := stack.Peek()
+	if err != nil {
+		return []BalanceIssue{{Kind: IssueUnexpectedClose, Position: position, Found: found}}
+	}
+
+	if top.pair == token.pair {
+		_, _ = stack.Pop()
+		return nil
+	}
+
+	issue := BalanceIssue{
+		Kind:     IssueMismatchedClose,
+		Position: position,
+		Found:    found,
+		Expected: bc.Pairs[top.pair].Close,
+		OpenedAt: positionAt(src, lines, top.start),
+	}
+
+	for depth := stack.Len() - 1; depth >= 0; depth-- {
+		if stack.Data[depth].pair == token.pair {
+			stack.Data = stack.Data[:depth]
+			break
+		}
+	}
+
+	return []BalanceIssue{issue}
+}
+
+// matchDelimiter recognizes the longest delimiter starting at offset i.
+//
+// A symmetric delimiter closes the innermost open pair of its kind and opens a new pair otherwise.
+func (bc *BalanceChecker) matchDelimiter(src string, i int, stack *Stack[delimiterToken]) (delimiterToken, bool) {
+	best := delimiterToken{pair: -1}
+	rest := src[i:]
+
+	for p, pair := range bc.Pairs {
+		if pair.Close != "" && pair.Close != pair.Open && strings.HasPrefix(rest, pair.Close) && i+len(pair.Close) > best.end {
+			best = delimiterToken{pair: p, open: false, start: i, end: i + len(pair.Close)}
+		}
+
+		if pair.Open != "" && strings.HasPrefix(rest, pair.Open) && i+len(pair.Open) > best.end {
+			open := true
+			if pair.Open == pair.Close {
+				top, err := stack.Peek()
+				open = err != nil || top.pair != p
+			}
+
+			best = delimiterToken{pair: p, open: open, start: i, end: i + len(pair.Open)}
+		}
+	}
+
+	return best, best.pair >= 0
+}
+
+// skipQuoted returns the offset just past the closing quote, whether the quote was closed and
+// whether the source ends with a dangling escape inside the unterminated quote.
+func (bc *BalanceChecker) skipQuoted(src string, i int, quote string) (int, bool, bool) {
+	dangling := false
+
+	for i < len(src) {
+		if bc.Escape != "" && strings.HasPrefix(src[i:], bc.Escape) {
+			i, dangling = bc.skipEscape(src, i)
+			continue
+		}
+
+		if strings.HasPrefix(src[i:], quote) {
+			return i + len(quote), true, false
+		}
+
+		_, size := utf8.DecodeRuneInString(src[i:])
+		i += size
+	}
+
+	return len(src), false, dangling
+}
+
+// skipEscape returns the offset just past the escape at offset i and the character it makes literal,
+// and whether the escape is dangling, i.e. the source ends right after it.
+func (bc *BalanceChecker) skipEscape(src string, i int) (int, bool) {
+	i += len(bc.Escape)
+	if i == len(src) {
+		return i, true
+	}
+
+	_, size := utf8.DecodeRuneInString(src[i:])
+
+	return i + size, false
+}
+
+// balanceFixer computes and applies the minimal set of insertions that balances a token sequence.
+//
+// cost[i][j] is the minimal number of insertions for tokens[i:j] and match[i][j] is the index
+// of the token paired with tokens[i] in an optimal solution, or -1 if tokens[i] stays unmatched.
+// When the table is not computed, plan falls back to greedy stack matching.
+//
+// The planned insertions are placed by place, which writes the fixed text to out and the insertions
+// actually made to insertions.
+type balanceFixer struct {
+	checker    *BalanceChecker
+	text       string
+	tokens     []delimiterToken
+	stops      []bool
+	delimiters []string
+	reach      int
+	cost       [][]int
+	match      [][]int
+	planned    []plannedInsertion
+	out        strings.Builder
+	insertions []Insertion
+}
+
+// plannedInsertion is a delimiter that balances the text when inserted at any offset from lo to hi,
+// preferably at offset, which is lo or hi.
+type plannedInsertion struct {
+	lo     int
+	hi     int
+	offset int
+	text   string
+}
+
+// newBalanceFixer creates a fixer for text, given its delimiters and, for every offset up to len(text),
+// whether its scan stands there.
+//
+// reach is the longest text a single step of the scan can read: a delimiter, a quote or an escape
+// with the character it makes literal.
+func newBalanceFixer(bc *BalanceChecker, text string, tokens []delimiterToken, stops []bool) *balanceFixer {
+	f := &balanceFixer{checker: bc, text: text, tokens: tokens, stops: stops}
+
+	for _, pair := range bc.Pairs {
+		f.delimiters = append(f.delimiters, pair.Open, pair.Close)
+		f.reach = max(f.reach, len(pair.Open), len(pair.Close))
+	}
+
+	for _, quote := range bc.Quotes {
+		f.reach = max(f.reach, len(quote))
+	}
+
+	if bc.Escape != "" {
+		f.reach = max(f.reach, len(bc.Escape)+utf8.UTFMax)
+	}
+
+	return f
+}
+
+// solve fills the dynamic programming tables over all token ranges.
+func (f *balanceFixer) solve() {
+	n := len(f.tokens)
+	f.cost = make([][]int, n+1)
+	f.match = make([][]int, n+1)
+
+	for i := range f.cost {
+		f.cost[i] = make([]int, n+1)
+		f.match[i] = make([]int, n+1)
+	}
+
+	for length := 1; length <= n; length++ {
+		for i := 0; i+length <= n; i++ {
+			j := i + length
+			f.cost[i][j] = 1 + f.cost[i+1][j]
+			f.match[i][j] = -1
+
+			if !f.tokens[i].open {
+				continue
+			}
+
+			for k := i + 1; k < j; k++ {
+				if f.tokens[k].open || f.tokens[k].pair != f.tokens[i].pair {
+					continue
+				}
+
+				if c := f.cost[i+1][k] + f.cost[k+1][j]; c < f.cost[i][j] {
+					f.cost[i][j] = c
+					f.match[i][j] = k
+				}
+			}
+		}
+	}
+}
+
+// fix plans the insertions for the whole text, exactly if the tables are computed and greedily otherwise,
+// and places them.
+//
+// Returns the fixed text.
+func (f *balanceFixer) fix() string {
+	f.planned, f.insertions = nil, nil
+	f.out.Reset()
+
+	f.plan(0, len(f.tokens), 0, len(f.text))
+	f.place()
+
+	return f.out.String()
+}
+
+// plan plans the insertions that balance text[from:to], which contains tokens[i:j].
+func (f *balanceFixer) plan(i, j, from, to int) {
+	if f.cost == nil {
+		f.planGreedy(i, j, from, to)
+		return
+	}
+
+	if i == j {
+		return
+	}
+
+	token := f.tokens[i]
+	pair := f.checker.Pairs[token.pair]
+
+	switch k := f.match[i][j]; {
+	case k >= 0:
+		f.plan(i+1, k, token.end, f.tokens[k].start)
+		f.plan(k+1, j, f.tokens[k].end, to)
+	case token.open:
+		f.plan(i+1, j, token.end, to)
+		f.insert(f.tokens[j-1].end, to, to, pair.Close)
+	default:
+		f.insert(from, token.start, from, pair.Open)
+		f.plan(i+1, j, token.end, to)
+	}
+}
+
+// planGreedy balances tokens[i:j] with a stack: unmatched closing delimiters get an opening one
+// right before them, a closing delimiter that matches deeper in the stack closes the pairs above it,
+// and pairs left open are closed at the end.
+func (f *balanceFixer) planGreedy(i, j, from, to int) {
+	stack := NewStack[delimiterToken](0)
+	last := from
+
+	for _, token := range f.tokens[i:j] {
+		if token.open {
+			stack.Push(token)
+			last = token.end
+
+			continue
+		}
+
+		depth := stack.Len() - 1
+		for depth >= 0 && stack.Data[depth].pair != token.pair {
+			depth--
+		}
+
+		if depth < 0 {
+			f.insert(last, token.start, token.start, f.checker.Pairs[token.pair].Open)
+			last = token.end
+
+			continue
+		}
+
+		for stack.Len()-1 > depth {
+			open, _ := stack.Pop()
+			f.insert(last, token.start, token.start, f.checker.Pairs[open.pair].Close)
+		}
+
+		_, _ = stack.Pop()
+		last = token.end
+	}
+
+	for !stack.IsEmpty() {
+		open, _ := stack.Pop()
+		f.insert(last, to, to, f.checker.Pairs[open.pair].Close)
+	}
+}
+
+// insert plans the insertion of text at any offset between lo and hi, preferably at offset.
+func (f *balanceFixer) insert(lo, hi, offset int, text string) {
+	f.planned = append(f.planned, plannedInsertion{lo: lo, hi: hi, offset: offset, text: text})
+}
+
+// place writes the text with the planned insertions to the output.
+//
+// Each insertion is placed where the scan of the output reads it as a single delimiter and reads its
+// neighbours as before. Only a window around the insertion is scanned: it starts at an offset where the
+// scan is known to stand, at least reach bytes before the insertion, as no step of the scan reads further.
+func (f *balanceFixer) place() {
+	stands := []int{0}
+	last := 0
+
+	for _, planned := range f.planned {
+		offset, text, next := f.position(planned, last, stands)
+
+		f.out.WriteString(f.text[last:offset])
+		f.out.WriteString(text)
+		f.insertions = append(f.insertions, Insertion{Position: Position{Offset: offset}, Text: text})
+
+		stands, last = next, offset
+	}
+
+	f.out.WriteString(f.text[last:])
+}
+
+// position chooses the offset of a planned insertion, no earlier than the previous one at last.
+//
+// It is the preferred offset or the closest one in the range of the insertion that keeps the neighbouring
+// delimiters intact. If there is none, the delimiter is inserted at the preferred offset with a space
+// before, after or around it.
+//
+// Returns the offset, the inserted text and the offsets of the output at which the scan stands after it.
+func (f *balanceFixer) position(planned plannedInsertion, last int, stands []int) (int, string, []int) {
+	lo, hi := max(planned.lo, last), max(planned.hi, last)
+	offset, step := max(planned.offset, last), 1
+	if planned.offset == planned.hi {
+		step = -1
+	}
+
+	for p := offset; lo <= p && p <= hi; p += step {
+		if !f.stops[p] {
+			continue
+		}
+
+		if next, ok := f.attempt(stands, last, p, "", planned.text, ""); ok {
+			return p, planned.text, next
+		}
+	}
+
+	for _, pad := range [][2]string{{" ", ""}, {"", " "}, {" ", " "}} {
+		if next, ok := f.attempt(stands, last, offset, pad[0], planned.text, pad[1]); ok {
+			return offset, pad[0] + planned.text + pad[1], next
+		}
+	}
+
+	return offset, planned.text, []int{f.out.Len() + offset - last + len(planned.text)}
+}
+
+// attempt reports whether delimiter, surrounded by before and after, can be inserted at offset p of the text,
+// when the output is written up to offset last of the text and the scan of the output stands at stands.
+//
+// Returns the offsets of the output at which the scan stands up to the end of the inserted text.
+func (f *balanceFixer) attempt(stands []int, last, p int, before, delimiter, after string) ([]int, bool) {
+	written := f.out.String()
+	at := len(written) + p - last
+
+	var anchor int
+	var left string
+
+	if x := f.stopBefore(last, p-f.reach); x >= 0 {
+		anchor, left = len(written)+x-last, f.text[x:p]
+	} else {
+		k := max(sort.SearchInts(stands, at-f.reach+1)-1, 0)
+		anchor, left = stands[k], written[stands[k]:]+f.text[last:p]
+	}
+
+	start, inserted := len(left), before+delimiter+after
+	window := left + inserted + f.text[p:min(p+f.reach, len(f.text))]
+
+	scanned, ok := f.fits(window, start, start+len(before), start+len(before)+len(delimiter), start+len(inserted))
+	if !ok {
+		return nil, false
+	}
+
+	for i := range scanned {
+		scanned[i] += anchor
+	}
+
+	return scanned, true
+}
+
+// stopBefore returns the largest offset between lo and hi at which the scan of the text stands, or -1.
+func (f *balanceFixer) stopBefore(lo, hi int) int {
+	for x := hi; x >= lo; x-- {
+		if f.stops[x] {
+			return x
+		}
+	}
+
+	return -1
+}
+
+// fits scans window from its start, where the scan of the output stands, and reports whether the scan
+// stands at start, reads window[from:to] as a single delimiter and stands at end.
+//
+// Returns the offsets of the window at which the scan stands up to end.
+func (f *balanceFixer) fits(window string, start, from, to, end int) ([]int, bool) {
+	var stands []int
+	atStart, matched := false, false
+
+	i := 0
+	for i < end {
+		stands = append(stands, i)
+		next, delimiter := f.step(window, i)
+
+		atStart = atStart || i == start
+		matched = matched || (i == from && delimiter && next == to)
+		i = next
+	}
+
+	return append(stands, i), atStart && matched && i == end
+}
+
+// step returns the offset at which the scan of s continues after offset i and whether it reads a delimiter there.
+func (f *balanceFixer) step(s string, i int) (int, bool) {
+	bc := f.checker
+
+	if bc.Escape != "" && strings.HasPrefix(s[i:], bc.Escape) {
+		next, _ := bc.skipEscape(s, i)
+		return next, false
+	}
+
+	if quote := longestPrefix(s[i:], bc.Quotes); quote != "" {
+		next, _, _ := bc.skipQuoted(s, i+len(quote), quote)
+		return next, false
+	}
+
+	if delimiter := longestPrefix(s[i:], f.delimiters); delimiter != "" {
+		return i + len(delimiter), true
+	}
+
+	_, size := utf8.DecodeRuneInString(s[i:])
+
+	return i + size, false
+}
+
+// longestPrefix returns the longest candidate that s starts with, or an empty string.
+func longestPrefix(s string, candidates []string) string {
+	best := ""
+	for _, c := range candidates {
+		if c != "" && len(c) > len(best) && strings.HasPrefix(s, c) {
+			best = c
+		}
+	}
+
+	return best
+}
+
+// lineStarts returns the byte offsets at which each line of src starts.
+func lineStarts(src string) []int {
+	starts := []int{0}
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+// positionAt converts a byte offset to a Position using precomputed line starts.
+func positionAt(src string, lines []int, offset int) Position {
+	line := sort.Search(len(lines), func(i int) bool { return lines[i] > offset }) - 1
+
+	return Position{
+		Offset: offset,
+		Line:   line + 1,
+		Column: utf8.RuneCountInString(src[lines[line]:offset]) + 1,
+	}
+}
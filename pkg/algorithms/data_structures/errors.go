@@ -0,0 +1,10 @@
+package data_structures
+
+import "errors"
+
+var (
+	ErrStackEmpty = errors.New("stack is empty")
+
+	ErrNothingToUndo = errors.New("nothing to undo")
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
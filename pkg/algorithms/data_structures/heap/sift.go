@@ -0,0 +1,54 @@
+package heap
+
+// SiftDown restores the heap property of an indexed collection of n elements, starting from index i.
+//
+// The collection is accessed only through its indices, so it serves algorithms that keep a heap inside
+// a slice they own, such as in-place heapsort. Unlike Heap, it never calls an equality function: it
+// stops at the first child that must not be above the element.
+//
+// 1. If no child of the i-th element must be above it, the subtree is already a heap;
+//
+// 2. Otherwise, swap the i-th element with the child that must be closest to the root;
+//
+// 3. Continue from the swapped child;
+//
+// 4. Runs in O(log n) time.
+//
+// Parameters:
+//   - i: the index of the element that may violate the heap property;
+//   - n: the number of elements in the heap;
+//   - less: reports whether the element at index a must be closer to the root than the element at index b;
+//   - swap: swaps the elements at indices a and b.
+func SiftDown(i, n int, less func(a, b int) bool, swap func(a, b int)) {
+	for 2*i+1 < n {
+		left := 2*i + 1
+		right := 2*i + 2
+		j := left
+
+		if right < n && less(right, left) {
+			j = right
+		}
+		if !less(j, i) {
+			break
+		}
+
+		swap(i, j)
+		i = j
+	}
+}
+
+// Build arranges an indexed collection of n elements into a heap.
+//
+// 1. Perform SiftDown for nodes with at least one child, from (n/2 - 1) to 0;
+//
+// 2. This approach runs in O(n) time.
+//
+// Parameters:
+//   - n: the number of elements;
+//   - less: reports whether the element at index a must be closer to the root than the element at index b;
+//   - swap: swaps the elements at indices a and b.
+func Build(n int, less func(a, b int) bool, swap func(a, b int)) {
+	for i := n/2 - 1; i >= 0; i-- {
+		SiftDown(i, n, less, swap)
+	}
+}
@@ -0,0 +1,310 @@
+package linked_lists
+
+import "fmt"
+
+// CircularLinkedList represents a singly linked list whose last node points back to the first one.
+//
+// Only the tail is stored: the head is always Tail.Next, so both ends are reachable in O(1).
+//
+// Fields:
+//   - Tail: a pointer to the last node in the list (nil if the list is empty);
+//   - LenOfList: the number of elements in the list;
+//   - Equals: a function to compare equality of two elements.
+type CircularLinkedList[T any] struct {
+	Tail      *NodeSinglyLinked[T]
+	LenOfList int
+	Equals    func(a, b T) bool
+}
+
+// NewCircularLinkedList creates a new empty circular linked list.
+//
+// Parameters:
+//   - equalsFunc: a function to compare equality of two elements.
+//
+// Returns a pointer to the new CircularLinkedList.
+func NewCircularLinkedList[T any](equalsFunc func(a, b T) bool) *CircularLinkedList[T] {
+	list := &CircularLinkedList[T]{Equals: equalsFunc}
+
+	return list
+}
+
+// Len returns the number of elements in the list.
+func (cll *CircularLinkedList[T]) Len() int {
+	return cll.LenOfList
+}
+
+// HeadOfList returns the pointer to the head of the list or nil if the list is empty.
+func (cll *CircularLinkedList[T]) HeadOfList() *NodeSinglyLinked[T] {
+	if cll.Tail == nil {
+		return nil
+	}
+
+	return cll.Tail.Next
+}
+
+// NodeAtPos returns the node at the specified position counting from the head.
+//
+// Parameters:
+//   - pos: the zero-based position of the node.
+//
+// Returns the node and an error if the position is invalid.
+func (cll *CircularLinkedList[T]) NodeAtPos(pos int) (*NodeSinglyLinked[T], error) {
+	if pos < 0 || pos >= cll.LenOfList {
+		return nil, ErrInvalidPos
+	}
+
+	current := cll.Tail.Next
+	for i := 0; i < pos; i++ {
+		current = current.Next
+	}
+
+	return current, nil
+}
+
+// InsertAtBeginning inserts a new element at the beginning of the list.
+//
+// Parameters:
+//   - elem: the element to be inserted.
+func (cll *CircularLinkedList[T]) InsertAtBeginning(elem T) {
+	newNode := &NodeSinglyLinked[T]{Value: elem}
+
+	if cll.Tail == nil {
+		newNode.Next = newNode
+		cll.Tail = newNode
+	} else {
+		newNode.Next = cll.Tail.Next
+		cll.Tail.Next = newNode
+	}
+
+	cll.LenOfList++
+}
+
+// InsertAtEnd inserts a new element at the end of the list.
+//
+// Parameters:
+//   - elem: the element to be inserted.
+func (cll *CircularLinkedList[T]) InsertAtEnd(elem T) {
+	cll.InsertAtBeginning(elem)
+	cll.Tail = cll.Tail.Next
+}
+
+// InsertAtPos inserts a new element at the specified position in the list.
+//
+// Parameters:
+//   - elem: the element to be inserted;
+//   - pos: the position to insert the element at.
+//
+// Returns an error if the position is invalid.
+func (cll *CircularLinkedList[T]) InsertAtPos(elem T, pos int) error {
+	if pos < 0 || pos > cll.LenOfList {
+		return ErrInvalidPos
+	}
+
+	if pos == 0 {
+		cll.InsertAtBeginning(elem)
+		return nil
+	}
+
+	if pos == cll.LenOfList {
+		cll.InsertAtEnd(elem)
+		return nil
+	}
+
+	prev, err := cll.NodeAtPos(pos - 1)
+	if err != nil {
+		return err
+	}
+
+	prev.Next = &NodeSinglyLinked[T]{Value: elem, Next: prev.Next}
+
+	cll.LenOfList++
+
+	return nil
+}
+
+// RemoveFirstNode removes the first element from the list.
+//
+// Returns an error if the list is empty.
+func (cll *CircularLinkedList[T]) RemoveFirstNode() error {
+	if cll.Tail == nil {
+		return ErrListEmpty
+	}
+
+	cll.removeAfter(cll.Tail)
+
+	return nil
+}
+
+// RemoveNodeAtPosition removes the element at the specified position from the list.
+//
+// Parameters:
+//   - position: the position of the element to be removed.
+//
+// Returns an error if the position is invalid or the list is empty.
+func (cll *CircularLinkedList[T]) RemoveNodeAtPosition(position int) error {
+	if position < 0 || position >= cll.LenOfList {
+		return ErrInvalidPos
+	}
+
+	prev := cll.Tail
+	if position > 0 {
+		prev, _ = cll.NodeAtPos(position - 1)
+	}
+
+	cll.removeAfter(prev)
+
+	return nil
+}
+
+// removeAfter unlinks the node that follows prev and returns its value.
+//
+// The tail pointer is moved back to prev when the tail itself is removed.
+func (cll *CircularLinkedList[T]) removeAfter(prev *NodeSinglyLinked[T]) T {
+	removed := prev.Next
+
+	if removed == prev {
+		cll.Tail = nil
+	} else {
+		prev.Next = removed.Next
+		if removed == cll.Tail {
+			cll.Tail = prev
+		}
+	}
+
+	removed.Next = nil
+	cll.LenOfList--
+
+	return removed.Value
+}
+
+// FindNode searches for the first occurrence of the specified value in the list.
+//
+// Parameters:
+//   - value: the value to search for.
+//
+// Returns the position of the element and an error if the value is not found.
+func (cll *CircularLinkedList[T]) FindNode(value T) (int, error) {
+	current := cll.HeadOfList()
+
+	for index := 0; index < cll.LenOfList; index++ {
+		if cll.Equals(current.Value, value) {
+			return index, nil
+		}
+
+		current = current.Next
+	}
+
+	return -1, ErrValueNotFound
+}
+
+// Rotate moves the head of the list k positions forward.
+//
+// A negative k rotates backwards; k is taken modulo the length of the list,
+// so rotating by any multiple of Len leaves the list unchanged.
+//
+// Parameters:
+//   - k: the number of positions to rotate by.
+func (cll *CircularLinkedList[T]) Rotate(k int) {
+	if cll.LenOfList == 0 {
+		return
+	}
+
+	k %= cll.LenOfList
+	if k < 0 {
+		k += cll.LenOfList
+	}
+
+	for i := 0; i < k; i++ {
+		cll.Tail = cll.Tail.Next
+	}
+}
+
+// RemoveEveryKth repeatedly counts k elements around the circle and removes the k-th one.
+//
+// Counting starts at the head. After every removal it resumes from the element following
+// the removed one, and the list is left with that element as its head, so successive
+// calls continue the same count. The process stops once only remaining elements are left.
+//
+// Parameters:
+//   - k: the counting step, must be positive;
+//   - remaining: the number of elements to keep in the list.
+//
+// Returns the removed values in removal order and an error if k or remaining is invalid.
+func (cll *CircularLinkedList[T]) RemoveEveryKth(k, remaining int) ([]T, error) {
+	if k < 1 {
+		return nil, ErrInvalidStep
+	}
+
+	if remaining < 0 || remaining > cll.LenOfList {
+		return nil, ErrInvalidPos
+	}
+
+	removed := make([]T, 0, cll.LenOfList-remaining)
+	prev := cll.Tail
+
+	for cll.LenOfList > remaining {
+		steps := (k - 1) % cll.LenOfList
+		for i := 0; i < steps; i++ {
+			prev = prev.Next
+		}
+
+		removed = append(removed, cll.removeAfter(prev))
+	}
+
+	if cll.Tail != nil {
+		cll.Tail = prev
+	}
+
+	return removed, nil
+}
+
+// All returns an iterator over the values of the list starting at the head.
+func (cll *CircularLinkedList[T]) All() func(yield func(T) bool) {
+	return cll.AllFrom(cll.HeadOfList())
+}
+
+// AllFrom returns an iterator that walks exactly one full circle starting at the given node.
+//
+// Parameters:
+//   - start: the node to start from; it must belong to the list.
+//
+// The iterator stops early if yield returns false or if start is nil.
+func (cll *CircularLinkedList[T]) AllFrom(start *NodeSinglyLinked[T]) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		current := start
+
+		for i := 0; i < cll.LenOfList && current != nil; i++ {
+			if !yield(current.Value) {
+				return
+			}
+
+			current = current.Next
+		}
+	}
+}
+
+// PrintList prints the elements of the list to the standard output.
+func (cll *CircularLinkedList[T]) PrintList() {
+	cll.All()(func(value T) bool {
+		fmt.Print(value, " ")
+		return true
+	})
+
+	fmt.Println()
+}
+
+// LinkedListToSlice converts the linked list to a slice starting at the head.
+func (cll *CircularLinkedList[T]) LinkedListToSlice() ([]T, error) {
+	var result []T
+
+	if cll.Tail == nil {
+		return result, ErrListEmpty
+	}
+
+	cll.All()(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
+
+	return result, nil
+}
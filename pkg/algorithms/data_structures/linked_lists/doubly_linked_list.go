@@ -245,6 +245,28 @@ func (dll *DoublyLinkedList[T]) Reverse() {
 	dll.Head = prev
 }
 
+// All returns an iterator over the values of the list from the head to the tail.
+func (dll *DoublyLinkedList[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := dll.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the list from the tail to the head.
+func (dll *DoublyLinkedList[T]) Backward() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := dll.Tail; current != nil; current = current.Prev {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
 // PrintList prints the elements of the list to the standard output.
 func (dll *DoublyLinkedList[T]) PrintList() {
 	current := dll.Head
@@ -7,4 +7,10 @@ var (
 	ErrPosOutOfRange = errors.New("position out of range")
 	ErrListEmpty     = errors.New("list is empty")
 	ErrValueNotFound = errors.New("value not found")
+	ErrInvalidStep   = errors.New("step must be positive")
+	ErrInvalidCount  = errors.New("count must be positive")
+
+	ErrNoCycle        = errors.New("list has no cycle")
+	ErrCycleDetected  = errors.New("list contains a cycle")
+	ErrLengthMismatch = errors.New("list length does not match node count")
 )
@@ -0,0 +1,62 @@
+package linked_lists
+
+// Josephus simulates the Josephus problem on a CircularLinkedList.
+//
+// n people numbered from 0 to n-1 stand in a circle; counting starts at person 0
+// and every k-th person is eliminated until a single survivor is left.
+//
+// Parameters:
+//   - n: the number of people, must be positive;
+//   - k: the counting step, must be positive.
+//
+// Returns the elimination order, the survivor and an error if n or k is invalid.
+//
+// Runs in O(n*k) time.
+func Josephus(n, k int) ([]int, int, error) {
+	if n < 1 {
+		return nil, -1, ErrInvalidCount
+	}
+
+	if k < 1 {
+		return nil, -1, ErrInvalidStep
+	}
+
+	circle := NewCircularLinkedList(func(a, b int) bool { return a == b })
+	for i := 0; i < n; i++ {
+		circle.InsertAtEnd(i)
+	}
+
+	order, err := circle.RemoveEveryKth(k, 1)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return order, circle.Tail.Value, nil
+}
+
+// JosephusSurvivor computes the survivor of the Josephus problem arithmetically.
+//
+// It uses the recurrence J(1) = 0, J(i) = (J(i-1) + k) mod i and is meant
+// as an O(n) cross-check for Josephus.
+//
+// Parameters:
+//   - n: the number of people, must be positive;
+//   - k: the counting step, must be positive.
+//
+// Returns the zero-based survivor and an error if n or k is invalid.
+func JosephusSurvivor(n, k int) (int, error) {
+	if n < 1 {
+		return -1, ErrInvalidCount
+	}
+
+	if k < 1 {
+		return -1, ErrInvalidStep
+	}
+
+	survivor := 0
+	for i := 2; i <= n; i++ {
+		survivor = (survivor + k) % i
+	}
+
+	return survivor, nil
+}
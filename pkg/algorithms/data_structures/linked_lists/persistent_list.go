@@ -0,0 +1,211 @@
+package linked_lists
+
+// consCell is an immutable node of a persistent List.
+//
+// Cells are never modified after creation, which is what allows several
+// lists to share the same tail.
+type consCell[T any] struct {
+	value T
+	next  *consCell[T]
+}
+
+// List represents an immutable singly linked (cons) list with structural sharing.
+//
+// Every operation returns a new List and leaves the receiver untouched; unchanged
+// suffixes are shared between versions instead of being copied. Because no cell is
+// ever mutated, a List can be shared between goroutines without locking.
+//
+// The zero value is an empty list ready to use.
+//
+// Fields:
+//   - head: a pointer to the first cell of the list;
+//   - length: the number of elements in the list.
+type List[T any] struct {
+	head   *consCell[T]
+	length int
+}
+
+// NewList creates a new persistent list containing the given values in order.
+//
+// Parameters:
+//   - values: optional initial values to populate the list.
+//
+// Returns the new List.
+func NewList[T any](values ...T) List[T] {
+	var l List[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Cons(values[i])
+	}
+
+	return l
+}
+
+// Len returns the number of elements in the list.
+func (l List[T]) Len() int {
+	return l.length
+}
+
+// IsEmpty reports whether the list has no elements.
+func (l List[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// Cons returns a new list with elem prepended; the receiver becomes its tail.
+//
+// Parameters:
+//   - elem: the element to be prepended.
+//
+// Runs in O(1) time.
+func (l List[T]) Cons(elem T) List[T] {
+	return List[T]{
+		head:   &consCell[T]{value: elem, next: l.head},
+		length: l.length + 1,
+	}
+}
+
+// Head returns the first element of the list.
+//
+// Returns an error if the list is empty.
+func (l List[T]) Head() (T, error) {
+	if l.head == nil {
+		var zero T
+		return zero, ErrListEmpty
+	}
+
+	return l.head.value, nil
+}
+
+// Tail returns the list without its first element, sharing all remaining cells.
+//
+// Returns an error if the list is empty.
+func (l List[T]) Tail() (List[T], error) {
+	if l.head == nil {
+		return l, ErrListEmpty
+	}
+
+	return List[T]{head: l.head.next, length: l.length - 1}, nil
+}
+
+// Reverse returns a new list with the elements in reverse order.
+//
+// Runs in O(n) time; no cells can be shared with the original list.
+func (l List[T]) Reverse() List[T] {
+	var result List[T]
+	for current := l.head; current != nil; current = current.next {
+		result = result.Cons(current.value)
+	}
+
+	return result
+}
+
+// Append returns a new list with the elements of other placed after the elements of l.
+//
+// Only the cells of l are copied; the resulting list shares every cell of other.
+//
+// Parameters:
+//   - other: the list to append.
+func (l List[T]) Append(other List[T]) List[T] {
+	if l.head == nil {
+		return other
+	}
+
+	if other.head == nil {
+		return l
+	}
+
+	return prependSlice(l.ToSlice(), other)
+}
+
+// Filter returns a new list with only the elements that satisfy keep.
+//
+// The longest suffix of l in which every element is kept is shared rather than copied.
+//
+// Parameters:
+//   - keep: a predicate that reports whether an element stays in the list.
+func (l List[T]) Filter(keep func(T) bool) List[T] {
+	var kept []T
+	var lastDropped *consCell[T]
+
+	// prefixLen is the number of kept elements before lastDropped and suffixLen the number of elements after it.
+	prefixLen, suffixLen := 0, 0
+
+	for current, i := l.head, 0; current != nil; current, i = current.next, i+1 {
+		if keep(current.value) {
+			kept = append(kept, current.value)
+			continue
+		}
+
+		lastDropped = current
+		prefixLen, suffixLen = len(kept), l.length-i-1
+	}
+
+	if lastDropped == nil {
+		return l
+	}
+
+	return prependSlice(kept[:prefixLen], List[T]{head: lastDropped.next, length: suffixLen})
+}
+
+// All returns an iterator over the values of the list.
+func (l List[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice converts the list to a newly allocated slice.
+func (l List[T]) ToSlice() []T {
+	result := make([]T, 0, l.length)
+	for current := l.head; current != nil; current = current.next {
+		result = append(result, current.value)
+	}
+
+	return result
+}
+
+// Map returns a new list with f applied to every element of l.
+//
+// Map is a function rather than a method because Go methods cannot introduce type parameters.
+//
+// Parameters:
+//   - l: the source list;
+//   - f: the function applied to each element.
+func Map[T, U any](l List[T], f func(T) U) List[U] {
+	mapped := make([]U, 0, l.length)
+	for current := l.head; current != nil; current = current.next {
+		mapped = append(mapped, f(current.value))
+	}
+
+	return prependSlice(mapped, List[U]{})
+}
+
+// Fold reduces the list from left to right into a single accumulated value.
+//
+// Parameters:
+//   - l: the list to fold;
+//   - initial: the starting value of the accumulator;
+//   - f: a function combining the accumulator with the next element.
+//
+// Returns the final value of the accumulator.
+func Fold[T, A any](l List[T], initial A, f func(acc A, elem T) A) A {
+	acc := initial
+	for current := l.head; current != nil; current = current.next {
+		acc = f(acc, current.value)
+	}
+
+	return acc
+}
+
+// prependSlice returns a list consisting of values followed by the cells of tail.
+func prependSlice[T any](values []T, tail List[T]) List[T] {
+	result := tail
+	for i := len(values) - 1; i >= 0; i-- {
+		result = result.Cons(values[i])
+	}
+
+	return result
+}
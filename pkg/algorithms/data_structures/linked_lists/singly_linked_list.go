@@ -244,6 +244,17 @@ func reverse[T any](current, prev *NodeSinglyLinked[T]) *NodeSinglyLinked[T] {
 	return reverse(next, current)
 }
 
+// All returns an iterator over the values of the list from the head to the end.
+func (sll *SinglyLinkedList[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := sll.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
 // PrintList prints the elements of the list to the standard output.
 func (sll *SinglyLinkedList[T]) PrintList() {
 	current := sll.Head
@@ -272,3 +283,183 @@ func (sll *SinglyLinkedList[T]) LinkedListToSlice() ([]T, error) {
 
 	return result, nil
 }
+
+// findCycleMeeting runs Floyd's tortoise and hare over the list.
+//
+// Returns the node where the two pointers meet or nil if the list has no cycle.
+func (sll *SinglyLinkedList[T]) findCycleMeeting() *NodeSinglyLinked[T] {
+	slow, fast := sll.Head, sll.Head
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+
+		if slow == fast {
+			return slow
+		}
+	}
+
+	return nil
+}
+
+// HasCycle reports whether following Next pointers from the head ever revisits a node.
+//
+// Uses Floyd's cycle detection: O(n) time and O(1) memory.
+func (sll *SinglyLinkedList[T]) HasCycle() bool {
+	return sll.findCycleMeeting() != nil
+}
+
+// CycleStart returns the first node of the cycle, i.e. the node that is reached twice.
+//
+// After the pointers of Floyd's algorithm meet, a pointer started at the head and one
+// started at the meeting point reach the start of the cycle after the same number of steps.
+//
+// Returns the node and an error if the list has no cycle.
+func (sll *SinglyLinkedList[T]) CycleStart() (*NodeSinglyLinked[T], error) {
+	meeting := sll.findCycleMeeting()
+	if meeting == nil {
+		return nil, ErrNoCycle
+	}
+
+	current := sll.Head
+	for current != meeting {
+		current = current.Next
+		meeting = meeting.Next
+	}
+
+	return current, nil
+}
+
+// CycleLength returns the number of nodes in the cycle or 0 if the list has no cycle.
+func (sll *SinglyLinkedList[T]) CycleLength() int {
+	meeting := sll.findCycleMeeting()
+	if meeting == nil {
+		return 0
+	}
+
+	length := 1
+	for current := meeting.Next; current != meeting; current = current.Next {
+		length++
+	}
+
+	return length
+}
+
+// BreakCycle turns a cyclic list back into a linear one by clearing the Next pointer
+// of the last node of the cycle.
+//
+// LenOfList is updated to the number of nodes left reachable from the head.
+//
+// Returns an error if the list has no cycle.
+func (sll *SinglyLinkedList[T]) BreakCycle() error {
+	start, err := sll.CycleStart()
+	if err != nil {
+		return err
+	}
+
+	length := 1
+	current := sll.Head
+	for current != start {
+		current = current.Next
+		length++
+	}
+
+	for current.Next != start {
+		current = current.Next
+		length++
+	}
+
+	current.Next = nil
+	sll.LenOfList = length
+
+	return nil
+}
+
+// Middle returns the middle node of the list; for an even number of nodes it is the second of the two middle nodes.
+//
+// Returns the node and an error if the list is empty or contains a cycle.
+func (sll *SinglyLinkedList[T]) Middle() (*NodeSinglyLinked[T], error) {
+	if sll.Head == nil {
+		return nil, ErrListEmpty
+	}
+
+	if sll.HasCycle() {
+		return nil, ErrCycleDetected
+	}
+
+	slow, fast := sll.Head, sll.Head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	return slow, nil
+}
+
+// KthFromEnd returns the k-th node counting from the end of the list, where k = 1 is the last node.
+//
+// 1. A lead pointer walks the list once and a trail pointer follows it k nodes behind, so the trail
+// stops at the k-th node from the end when the lead runs off the list;
+//
+// 2. A cycle is detected on the same walk with Brent's algorithm: the lead is compared with a node it
+// passed earlier, which is moved up to the lead whenever the number of steps since reaches the next
+// power of two, so a lead that goes around a cycle meets it within O(n) steps.
+//
+// Parameters:
+//   - k: the one-based position counting from the end.
+//
+// Returns the node and an error if k is out of range or the list contains a cycle.
+func (sll *SinglyLinkedList[T]) KthFromEnd(k int) (*NodeSinglyLinked[T], error) {
+	if k < 1 {
+		return nil, ErrInvalidPos
+	}
+
+	lead, trail := sll.Head, sll.Head
+	mark, power, steps := sll.Head, 1, 0
+
+	walked := 0
+	for ; lead != nil; walked++ {
+		if walked >= k {
+			trail = trail.Next
+		}
+
+		lead = lead.Next
+		if lead == mark {
+			return nil, ErrCycleDetected
+		}
+
+		if steps++; steps == power {
+			mark, power, steps = lead, 2*power, 0
+		}
+	}
+
+	if walked < k {
+		return nil, ErrPosOutOfRange
+	}
+
+	return trail, nil
+}
+
+// Validate checks the structural integrity of the list.
+//
+// Because Head and Next are exported, callers may link nodes by hand; Validate detects
+// such corruption without hanging on it.
+//
+// Returns ErrCycleDetected if the list contains a cycle, an error wrapping ErrLengthMismatch
+// if LenOfList differs from the actual number of nodes, or nil if the list is consistent.
+func (sll *SinglyLinkedList[T]) Validate() error {
+	if sll.HasCycle() {
+		return ErrCycleDetected
+	}
+
+	count := 0
+	for current := sll.Head; current != nil; current = current.Next {
+		count++
+	}
+
+	if count != sll.LenOfList {
+		return fmt.Errorf("%w: counted %d nodes, LenOfList is %d", ErrLengthMismatch, count, sll.LenOfList)
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package linked_lists
+
+import "fmt"
+
+// DefaultUnrolledNodeCapacity is the node capacity used when a capacity below 2 is requested.
+//
+// A node must be able to hold at least two values, otherwise splitting a full node cannot make room.
+const DefaultUnrolledNodeCapacity = 64
+
+// NodeUnrolled represents a node of an unrolled linked list holding a small array of values.
+//
+// Fields:
+//   - Values: the values stored in the node, at most the capacity of the list;
+//   - Next: a pointer to the next node in the list;
+//   - Prev: a pointer to the previous node in the list.
+type NodeUnrolled[T any] struct {
+	Values []T
+	Next   *NodeUnrolled[T]
+	Prev   *NodeUnrolled[T]
+}
+
+// UnrolledLinkedList represents a doubly linked list of nodes that each store up to NodeCapacity values.
+//
+// Keeping several values per node improves cache locality and cuts the number of pointer hops
+// during positional access, while insertions in the middle still only touch a single node.
+//
+// Fields:
+//   - Head: a pointer to the first node in the list;
+//   - Tail: a pointer to the last node in the list;
+//   - LenOfList: the number of elements in the list;
+//   - NodeCapacity: the maximum number of values in a single node;
+//   - Equals: a function to compare equality of two elements.
+type UnrolledLinkedList[T any] struct {
+	Head         *NodeUnrolled[T]
+	Tail         *NodeUnrolled[T]
+	LenOfList    int
+	NodeCapacity int
+	Equals       func(a, b T) bool
+}
+
+// NewUnrolledLinkedList creates a new empty unrolled linked list.
+//
+// Parameters:
+//   - nodeCapacity: the maximum number of values per node; DefaultUnrolledNodeCapacity is used if it is less than 2;
+//   - equalsFunc: a function to compare equality of two elements.
+//
+// Returns a pointer to the new UnrolledLinkedList.
+func NewUnrolledLinkedList[T any](nodeCapacity int, equalsFunc func(a, b T) bool) *UnrolledLinkedList[T] {
+	if nodeCapacity < 2 {
+		nodeCapacity = DefaultUnrolledNodeCapacity
+	}
+
+	list := &UnrolledLinkedList[T]{
+		NodeCapacity: nodeCapacity,
+		Equals:       equalsFunc,
+	}
+
+	return list
+}
+
+// Len returns the number of elements in the list.
+func (ull *UnrolledLinkedList[T]) Len() int {
+	return ull.LenOfList
+}
+
+// InsertAtBeginning inserts a new element at the beginning of the list.
+//
+// Parameters:
+//   - elem: the element to be inserted.
+func (ull *UnrolledLinkedList[T]) InsertAtBeginning(elem T) {
+	_ = ull.InsertAtPos(elem, 0)
+}
+
+// InsertAtEnd inserts a new element at the end of the list.
+//
+// Parameters:
+//   - elem: the element to be inserted.
+func (ull *UnrolledLinkedList[T]) InsertAtEnd(elem T) {
+	_ = ull.InsertAtPos(elem, ull.LenOfList)
+}
+
+// InsertAtPos inserts a new element at the specified position in the list.
+//
+// A full node is split in two halves before the insertion, so every node stays at least half full
+// after a split and no other node is touched.
+//
+// Parameters:
+//   - elem: the element to be inserted;
+//   - pos: the position to insert the element at.
+//
+// Returns an error if the position is invalid.
+func (ull *UnrolledLinkedList[T]) InsertAtPos(elem T, pos int) error {
+	if pos < 0 || pos > ull.LenOfList {
+		return ErrInvalidPos
+	}
+
+	if ull.Head == nil {
+		node := &NodeUnrolled[T]{Values: make([]T, 0, ull.NodeCapacity)}
+		ull.Head = node
+		ull.Tail = node
+	}
+
+	var node *NodeUnrolled[T]
+	var offset int
+
+	if pos == ull.LenOfList {
+		node, offset = ull.Tail, len(ull.Tail.Values)
+	} else {
+		node, offset = ull.locate(pos)
+	}
+
+	if len(node.Values) == ull.NodeCapacity {
+		ull.split(node)
+
+		if offset > len(node.Values) {
+			offset -= len(node.Values)
+			node = node.Next
+		}
+	}
+
+	var zero T
+	node.Values = append(node.Values, zero)
+	copy(node.Values[offset+1:], node.Values[offset:])
+	node.Values[offset] = elem
+
+	ull.LenOfList++
+
+	return nil
+}
+
+// RemoveFirstNode removes the first element from the list.
+//
+// Returns an error if the list is empty.
+func (ull *UnrolledLinkedList[T]) RemoveFirstNode() error {
+	if ull.LenOfList == 0 {
+		return ErrListEmpty
+	}
+
+	return ull.RemoveNodeAtPosition(0)
+}
+
+// RemoveLastNode removes the last element from the list.
+//
+// Returns an error if the list is empty.
+func (ull *UnrolledLinkedList[T]) RemoveLastNode() error {
+	if ull.LenOfList == 0 {
+		return ErrListEmpty
+	}
+
+	return ull.RemoveNodeAtPosition(ull.LenOfList - 1)
+}
+
+// RemoveNodeAtPosition removes the element at the specified position from the list.
+//
+// When a node drops below half of its capacity it is merged with its successor
+// if the two fit into a single node, and empty nodes are unlinked.
+//
+// Parameters:
+//   - position: the position of the element to be removed.
+//
+// Returns an error if the position is invalid or the list is empty.
+func (ull *UnrolledLinkedList[T]) RemoveNodeAtPosition(position int) error {
+	if position < 0 || position >= ull.LenOfList {
+		return ErrInvalidPos
+	}
+
+	node, offset := ull.locate(position)
+
+	var zero T
+	copy(node.Values[offset:], node.Values[offset+1:])
+	node.Values[len(node.Values)-1] = zero
+	node.Values = node.Values[:len(node.Values)-1]
+
+	ull.LenOfList--
+
+	if len(node.Values) == 0 {
+		ull.unlink(node)
+	} else if len(node.Values) < ull.NodeCapacity/2 && node.Next != nil &&
+		len(node.Values)+len(node.Next.Values) <= ull.NodeCapacity {
+		next := node.Next
+		node.Values = append(node.Values, next.Values...)
+		ull.unlink(next)
+	}
+
+	return nil
+}
+
+// FindNode searches for the first occurrence of the specified value in the list.
+//
+// Parameters:
+//   - value: the value to search for.
+//
+// Returns the position of the element and an error if the value is not found.
+func (ull *UnrolledLinkedList[T]) FindNode(value T) (int, error) {
+	index := 0
+
+	for current := ull.Head; current != nil; current = current.Next {
+		for _, v := range current.Values {
+			if ull.Equals(v, value) {
+				return index, nil
+			}
+
+			index++
+		}
+	}
+
+	return -1, ErrValueNotFound
+}
+
+// Reverse reverses the linked list by reversing the order of the nodes and the values inside each node.
+func (ull *UnrolledLinkedList[T]) Reverse() {
+	current := ull.Head
+	ull.Head, ull.Tail = ull.Tail, ull.Head
+
+	for current != nil {
+		values := current.Values
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+
+		next := current.Next
+		current.Next, current.Prev = current.Prev, next
+		current = next
+	}
+}
+
+// All returns an iterator over the values of the list from the head to the tail.
+func (ull *UnrolledLinkedList[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for node := ull.Head; node != nil; node = node.Next {
+			for _, value := range node.Values {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PrintList prints the elements of the list to the standard output.
+func (ull *UnrolledLinkedList[T]) PrintList() {
+	for current := ull.Head; current != nil; current = current.Next {
+		for _, v := range current.Values {
+			fmt.Print(v, " ")
+		}
+	}
+
+	fmt.Println()
+}
+
+// LinkedListToSlice converts the linked list to a slice.
+func (ull *UnrolledLinkedList[T]) LinkedListToSlice() ([]T, error) {
+	var result []T
+
+	if ull.LenOfList == 0 {
+		return result, ErrListEmpty
+	}
+
+	result = make([]T, 0, ull.LenOfList)
+	for current := ull.Head; current != nil; current = current.Next {
+		result = append(result, current.Values...)
+	}
+
+	return result, nil
+}
+
+// locate finds the node holding the element at pos and the offset of the element inside that node.
+//
+// The walk starts from whichever end of the list is closer to pos.
+func (ull *UnrolledLinkedList[T]) locate(pos int) (*NodeUnrolled[T], int) {
+	if pos < ull.LenOfList/2 {
+		current := ull.Head
+		for pos >= len(current.Values) {
+			pos -= len(current.Values)
+			current = current.Next
+		}
+
+		return current, pos
+	}
+
+	current := ull.Tail
+	fromEnd := ull.LenOfList - 1 - pos
+	for fromEnd >= len(current.Values) {
+		fromEnd -= len(current.Values)
+		current = current.Prev
+	}
+
+	return current, len(current.Values) - 1 - fromEnd
+}
+
+// split moves the upper half of node's values into a new node inserted right after it.
+func (ull *UnrolledLinkedList[T]) split(node *NodeUnrolled[T]) {
+	half := len(node.Values) / 2
+
+	newNode := &NodeUnrolled[T]{
+		Values: make([]T, 0, ull.NodeCapacity),
+		Next:   node.Next,
+		Prev:   node,
+	}
+	newNode.Values = append(newNode.Values, node.Values[half:]...)
+
+	var zero T
+	for i := half; i < len(node.Values); i++ {
+		node.Values[i] = zero
+	}
+	node.Values = node.Values[:half]
+
+	if node.Next != nil {
+		node.Next.Prev = newNode
+	} else {
+		ull.Tail = newNode
+	}
+
+	node.Next = newNode
+}
+
+// unlink removes node from the chain of nodes.
+func (ull *UnrolledLinkedList[T]) unlink(node *NodeUnrolled[T]) {
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		ull.Head = node.Next
+	}
+
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		ull.Tail = node.Prev
+	}
+
+	node.Next = nil
+	node.Prev = nil
+}
@@ -0,0 +1,225 @@
+package data_structures
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// NextGreater returns, for every element, the index of the nearest element to its right that is strictly greater.
+//
+// A stack of indices whose values are non-increasing is maintained; an element pops every
+// smaller element from the stack, becoming their answer. Runs in O(n) time.
+//
+// Parameters:
+//   - data: the input slice;
+//   - less: a function that returns true if a is less than b.
+//
+// Returns a slice of indices, -1 where no such element exists.
+func NextGreater[T any](data []T, less func(a, b T) bool) []int {
+	return nextIndices(data, func(top, current T) bool { return less(top, current) })
+}
+
+// NextSmaller returns, for every element, the index of the nearest element to its right that is strictly smaller.
+//
+// Parameters:
+//   - data: the input slice;
+//   - less: a function that returns true if a is less than b.
+//
+// Returns a slice of indices, -1 where no such element exists.
+func NextSmaller[T any](data []T, less func(a, b T) bool) []int {
+	return nextIndices(data, func(top, current T) bool { return less(current, top) })
+}
+
+// PrevGreater returns, for every element, the index of the nearest element to its left that is strictly greater.
+//
+// Parameters:
+//   - data: the input slice;
+//   - less: a function that returns true if a is less than b.
+//
+// Returns a slice of indices, -1 where no such element exists.
+func PrevGreater[T any](data []T, less func(a, b T) bool) []int {
+	return prevIndices(data, func(top, current T) bool { return !less(current, top) })
+}
+
+// PrevSmaller returns, for every element, the index of the nearest element to its left that is strictly smaller.
+//
+// Parameters:
+//   - data: the input slice;
+//   - less: a function that returns true if a is less than b.
+//
+// Returns a slice of indices, -1 where no such element exists.
+func PrevSmaller[T any](data []T, less func(a, b T) bool) []int {
+	return prevIndices(data, func(top, current T) bool { return !less(top, current) })
+}
+
+// nextIndices resolves the pending indices on the stack that the current element answers.
+//
+// resolves reports whether the element on top of the stack finds its answer in the current element.
+func nextIndices[T any](data []T, resolves func(top, current T) bool) []int {
+	result := make([]int, len(data))
+	stack := NewStack[int](len(data))
+
+	for i := range data {
+		result[i] = -1
+
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if !resolves(data[top], data[i]) {
+				break
+			}
+
+			result[top] = i
+			_, _ = stack.Pop()
+		}
+
+		stack.Push(i)
+	}
+
+	return result
+}
+
+// prevIndices discards the stack elements that cannot be the answer for the current element or anything after it.
+//
+// discard reports whether the element on top of the stack should be removed.
+func prevIndices[T any](data []T, discard func(top, current T) bool) []int {
+	result := make([]int, len(data))
+	stack := NewStack[int](len(data))
+
+	for i := range data {
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if !discard(data[top], data[i]) {
+				break
+			}
+
+			_, _ = stack.Pop()
+		}
+
+		result[i] = -1
+		if top, err := stack.Peek(); err == nil {
+			result[i] = top
+		}
+
+		stack.Push(i)
+	}
+
+	return result
+}
+
+// StockSpan returns, for every day, the number of consecutive days ending with it
+// on which the price was less than or equal to that day's price.
+//
+// Parameters:
+//   - prices: the daily prices;
+//   - less: a function that returns true if a is less than b.
+//
+// Returns a slice of spans, each at least 1.
+func StockSpan[T any](prices []T, less func(a, b T) bool) []int {
+	spans := PrevGreater(prices, less)
+	for i, prev := range spans {
+		spans[i] = i - prev
+	}
+
+	return spans
+}
+
+// LargestRectangle returns the area of the largest rectangle that fits under a histogram.
+//
+// Every bar is extended left and right up to the nearest strictly lower bars, found with
+// PrevSmaller and NextSmaller. Runs in O(n) time.
+//
+// Parameters:
+//   - heights: the non-negative heights of bars of width 1.
+//
+// Returns the largest area, 0 for an empty histogram.
+func LargestRectangle[T Number](heights []T) T {
+	less := func(a, b T) bool { return a < b }
+	left := PrevSmaller(heights, less)
+	right := NextSmaller(heights, less)
+
+	var best T
+	for i, h := range heights {
+		end := right[i]
+		if end == -1 {
+			end = len(heights)
+		}
+
+		if area := h * T(end-left[i]-1); area > best {
+			best = area
+		}
+	}
+
+	return best
+}
+
+// MaximalRectangle returns the area of the largest rectangle containing only true cells in a binary matrix.
+//
+// Each row is turned into a histogram of consecutive true cells above it, and LargestRectangle
+// is applied row by row. Runs in O(rows * cols) time.
+//
+// Parameters:
+//   - matrix: the binary matrix; rows may have different lengths, missing cells count as false.
+//
+// Returns the largest area.
+func MaximalRectangle(matrix [][]bool) int {
+	cols := 0
+	for _, row := range matrix {
+		cols = max(cols, len(row))
+	}
+
+	heights := make([]int, cols)
+	best := 0
+
+	for _, row := range matrix {
+		for j := range heights {
+			if j < len(row) && row[j] {
+				heights[j]++
+			} else {
+				heights[j] = 0
+			}
+		}
+
+		best = max(best, LargestRectangle(heights))
+	}
+
+	return best
+}
+
+// TrapRainWater returns how much water is trapped between the bars of an elevation map after raining.
+//
+// A stack of bars with decreasing heights is kept; when a higher bar arrives, the water above
+// each popped bar is bounded by the new bar and the bar below it on the stack. Runs in O(n) time.
+//
+// Parameters:
+//   - heights: the non-negative heights of bars of width 1.
+//
+// Returns the total amount of trapped water.
+func TrapRainWater[T Number](heights []T) T {
+	var water T
+	stack := NewStack[int](len(heights))
+
+	for i, h := range heights {
+		for !stack.IsEmpty() {
+			top, _ := stack.Peek()
+			if heights[top] >= h {
+				break
+			}
+
+			bottom, _ := stack.Pop()
+
+			left, err := stack.Peek()
+			if err != nil {
+				break
+			}
+
+			bounded := min(heights[left], h) - heights[bottom]
+			water += bounded * T(i-left-1)
+		}
+
+		stack.Push(i)
+	}
+
+	return water
+}
@@ -249,6 +249,28 @@ func (d *Deque[T]) Reverse() {
 	}
 }
 
+// All returns an iterator over the values of the deque from the front to the back.
+func (d *Deque[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := d.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the deque from the back to the front.
+func (d *Deque[T]) Backward() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := d.Tail; current != nil; current = current.Prev {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
 // PrintDeque prints the elements of the deque to the standard output.
 func (d *Deque[T]) PrintDeque() {
 	current := d.Head
@@ -130,6 +130,18 @@ func (pq *PriorityQueue[T]) Reverse() {
 	}
 }
 
+// All returns an iterator over the values of the priority queue in heap order, which is not sorted:
+// only the first value is guaranteed to have the highest priority.
+func (pq *PriorityQueue[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for _, value := range pq.HeapData.Data {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
 // PrintQueue prints the elements of the priority queue to the standard output.
 func (pq *PriorityQueue[T]) PrintQueue() {
 	err := pq.HeapData.PrintHeap()
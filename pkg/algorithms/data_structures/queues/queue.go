@@ -60,6 +60,7 @@ func (q *Queue[T]) Push(elem T) {
 	if q.LenOfQueue == 0 {
 		q.Head = newNode
 		q.Tail = newNode
+		q.LenOfQueue++
 
 		return
 	}
@@ -203,6 +204,17 @@ func reverseQueue[T any](current, prev *linked_lists.NodeSinglyLinked[T]) (*link
 	return head, tail
 }
 
+// All returns an iterator over the values of the queue from the front to the back.
+func (q *Queue[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for current := q.Head; current != nil; current = current.Next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
 // PrintQueue prints the elements of the queue to the standard output.
 func (q *Queue[T]) PrintQueue() {
 	current := q.Head
@@ -1 +1,303 @@
 package data_structures
+
+import "fmt"
+
+// Stack represents a LIFO stack backed by a slice.
+//
+// Fields:
+//   - Data: a slice containing stack elements, the top of the stack is the last element.
+type Stack[T any] struct {
+	Data []T
+}
+
+// NewStack creates a new empty stack.
+//
+// Parameters:
+//   - capacity: the initial capacity of the underlying slice, ignored if it is not positive.
+//
+// Returns a pointer to the new Stack.
+func NewStack[T any](capacity int) *Stack[T] {
+	stack := &Stack[T]{}
+	if capacity > 0 {
+		stack.Data = make([]T, 0, capacity)
+	}
+
+	return stack
+}
+
+// Len returns the number of elements in the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.Data)
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.Data) == 0
+}
+
+// Push adds a new element to the top of the stack.
+//
+// Parameters:
+//   - elem: the element to be added.
+//
+// Runs in amortized O(1) time.
+func (s *Stack[T]) Push(elem T) {
+	s.Data = append(s.Data, elem)
+}
+
+// Pop removes the element from the top of the stack and returns its value.
+//
+// Returns the value of the top element and an error if the stack is empty.
+func (s *Stack[T]) Pop() (T, error) {
+	var zero T
+	if len(s.Data) == 0 {
+		return zero, ErrStackEmpty
+	}
+
+	last := len(s.Data) - 1
+	result := s.Data[last]
+
+	s.Data[last] = zero
+	s.Data = s.Data[:last]
+
+	return result, nil
+}
+
+// Peek returns the element from the top of the stack without removing it.
+//
+// Returns the value of the top element and an error if the stack is empty.
+func (s *Stack[T]) Peek() (T, error) {
+	if len(s.Data) == 0 {
+		var zero T
+		return zero, ErrStackEmpty
+	}
+
+	return s.Data[len(s.Data)-1], nil
+}
+
+// Clear removes all elements from the stack, keeping the allocated capacity.
+func (s *Stack[T]) Clear() {
+	var zero T
+	for i := range s.Data {
+		s.Data[i] = zero
+	}
+
+	s.Data = s.Data[:0]
+}
+
+// ToSlice returns a copy of the stack elements ordered from the bottom to the top.
+func (s *Stack[T]) ToSlice() []T {
+	result := make([]T, len(s.Data))
+	copy(result, s.Data)
+
+	return result
+}
+
+// PrintStack prints the elements of the stack from the bottom to the top to the standard output.
+func (s *Stack[T]) PrintStack() {
+	for _, v := range s.Data {
+		fmt.Print(v, " ")
+	}
+
+	fmt.Println()
+}
+
+// minStackEntry pairs a stack element with the minimum of the stack at the moment it was pushed.
+type minStackEntry[T any] struct {
+	value T
+	min   T
+}
+
+// MinStack represents a stack that reports its minimum element in O(1) time.
+//
+// Every entry remembers the minimum of the stack below and including itself,
+// so popping an element restores the previous minimum without a scan.
+//
+// Fields:
+//   - Comparator: a function that returns true if a is less than b.
+//     Example: func Comparator(a, b int) bool { return a < b }; - Min returns the smallest element;
+//     func Comparator(a, b int) bool { return a > b }; - Min returns the largest element.
+type MinStack[T any] struct {
+	entries    Stack[minStackEntry[T]]
+	Comparator func(a, b T) bool
+}
+
+// NewMinStack creates a new empty MinStack.
+//
+// Parameters:
+//   - comparator: a function that returns true if a is less than b.
+//
+// Returns a pointer to the new MinStack.
+func NewMinStack[T any](comparator func(a, b T) bool) *MinStack[T] {
+	return &MinStack[T]{Comparator: comparator}
+}
+
+// Len returns the number of elements in the stack.
+func (ms *MinStack[T]) Len() int {
+	return ms.entries.Len()
+}
+
+// Push adds a new element to the top of the stack.
+//
+// Parameters:
+//   - elem: the element to be added.
+func (ms *MinStack[T]) Push(elem T) {
+	entry := minStackEntry[T]{value: elem, min: elem}
+
+	if top, err := ms.entries.Peek(); err == nil && ms.Comparator(top.min, elem) {
+		entry.min = top.min
+	}
+
+	ms.entries.Push(entry)
+}
+
+// Pop removes the element from the top of the stack and returns its value.
+//
+// Returns the value of the top element and an error if the stack is empty.
+func (ms *MinStack[T]) Pop() (T, error) {
+	entry, err := ms.entries.Pop()
+
+	return entry.value, err
+}
+
+// Peek returns the element from the top of the stack without removing it.
+//
+// Returns the value of the top element and an error if the stack is empty.
+func (ms *MinStack[T]) Peek() (T, error) {
+	entry, err := ms.entries.Peek()
+
+	return entry.value, err
+}
+
+// Min returns the minimum element of the stack according to the Comparator.
+//
+// Returns the minimum and an error if the stack is empty.
+func (ms *MinStack[T]) Min() (T, error) {
+	entry, err := ms.entries.Peek()
+
+	return entry.min, err
+}
+
+// Clear removes all elements from the stack.
+func (ms *MinStack[T]) Clear() {
+	ms.entries.Clear()
+}
+
+// ToSlice returns a copy of the stack elements ordered from the bottom to the top.
+func (ms *MinStack[T]) ToSlice() []T {
+	result := make([]T, ms.entries.Len())
+	for i, entry := range ms.entries.Data {
+		result[i] = entry.value
+	}
+
+	return result
+}
+
+// UndoStack represents an undo/redo history of actions with an optional bound on its size.
+//
+// Recording a new action clears the redo history. When the history is bounded and full,
+// the oldest action is discarded to make room for the new one.
+//
+// Fields:
+//   - Limit: the maximum number of actions kept in the undo history, 0 means unbounded.
+type UndoStack[T any] struct {
+	undo  Stack[T]
+	redo  Stack[T]
+	Limit int
+}
+
+// NewUndoStack creates a new empty UndoStack.
+//
+// Parameters:
+//   - limit: the maximum number of actions kept in the undo history; a non-positive value means unbounded.
+//
+// Returns a pointer to the new UndoStack.
+func NewUndoStack[T any](limit int) *UndoStack[T] {
+	if limit < 0 {
+		limit = 0
+	}
+
+	return &UndoStack[T]{Limit: limit}
+}
+
+// Do records a new action, discarding the redo history.
+//
+// Parameters:
+//   - action: the action that has just been performed.
+func (us *UndoStack[T]) Do(action T) {
+	us.redo.Clear()
+	us.push(action)
+}
+
+// Undo moves the most recent action to the redo history and returns it so the caller can revert it.
+//
+// Returns the action and an error if there is nothing to undo.
+func (us *UndoStack[T]) Undo() (T, error) {
+	action, err := us.undo.Pop()
+	if err != nil {
+		return action, ErrNothingToUndo
+	}
+
+	us.redo.Push(action)
+
+	return action, nil
+}
+
+// Redo moves the most recently undone action back to the undo history and returns it so the caller can reapply it.
+//
+// Returns the action and an error if there is nothing to redo.
+func (us *UndoStack[T]) Redo() (T, error) {
+	action, err := us.redo.Pop()
+	if err != nil {
+		return action, ErrNothingToRedo
+	}
+
+	us.push(action)
+
+	return action, nil
+}
+
+// CanUndo reports whether there is an action to undo.
+func (us *UndoStack[T]) CanUndo() bool {
+	return !us.undo.IsEmpty()
+}
+
+// CanRedo reports whether there is an action to redo.
+func (us *UndoStack[T]) CanRedo() bool {
+	return !us.redo.IsEmpty()
+}
+
+// UndoLen returns the number of actions in the undo history.
+func (us *UndoStack[T]) UndoLen() int {
+	return us.undo.Len()
+}
+
+// RedoLen returns the number of actions in the redo history.
+func (us *UndoStack[T]) RedoLen() int {
+	return us.redo.Len()
+}
+
+// History returns a copy of the undo history ordered from the oldest to the most recent action.
+func (us *UndoStack[T]) History() []T {
+	return us.undo.ToSlice()
+}
+
+// Clear removes both the undo and the redo history.
+func (us *UndoStack[T]) Clear() {
+	us.undo.Clear()
+	us.redo.Clear()
+}
+
+// push adds an action to the undo history, dropping the oldest ones so that at most Limit actions are kept,
+// also after Limit has been lowered.
+func (us *UndoStack[T]) push(action T) {
+	if n := us.undo.Len(); us.Limit > 0 && n >= us.Limit {
+		drop := n - us.Limit + 1
+
+		copy(us.undo.Data, us.undo.Data[drop:])
+		clear(us.undo.Data[n-drop:])
+		us.undo.Data = us.undo.Data[:n-drop]
+	}
+
+	us.undo.Push(action)
+}
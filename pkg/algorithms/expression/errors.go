@@ -0,0 +1,52 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnexpectedChar   = errors.New("unexpected character")
+	ErrInvalidNumber    = errors.New("invalid number")
+	ErrUnexpectedToken  = errors.New("unexpected token")
+	ErrUnexpectedEnd    = errors.New("unexpected end of expression")
+	ErrMismatchedParens = errors.New("mismatched parentheses")
+	ErrUnknownFunction  = errors.New("unknown function")
+	ErrWrongArgCount    = errors.New("wrong number of arguments")
+	ErrUnknownVariable  = errors.New("unknown variable")
+	ErrDivisionByZero   = errors.New("division by zero")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrMalformedPostfix = errors.New("malformed postfix expression")
+	ErrEmptyExpression  = errors.New("empty expression")
+)
+
+// Error describes a problem found while parsing or evaluating an expression.
+//
+// Fields:
+//   - Column: the one-based column of the offending character or token in the source;
+//   - Err: the sentinel error describing the kind of problem;
+//   - Detail: an optional human readable detail, e.g. the offending token.
+type Error struct {
+	Column int
+	Err    error
+	Detail string
+}
+
+// Error formats the error together with its column.
+func (e *Error) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("column %d: %v", e.Column, e.Err)
+	}
+
+	return fmt.Sprintf("column %d: %v: %s", e.Column, e.Err, e.Detail)
+}
+
+// Unwrap returns the sentinel error so callers can match it with errors.Is.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// newError creates an *Error for the given column.
+func newError(column int, err error, detail string) *Error {
+	return &Error{Column: column, Err: err, Detail: detail}
+}
@@ -0,0 +1,172 @@
+package expression
+
+import (
+	"math"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/math_algo"
+)
+
+// Operator describes an operator for the shunting-yard algorithm and how to apply it.
+//
+// Boolean results are represented as 1 (true) and 0 (false); any non-zero operand is truthy.
+//
+// Fields:
+//   - Precedence: the binding strength, higher binds tighter;
+//   - RightAssociative: whether operators of equal precedence group from the right, e.g. 2^3^2 = 2^(3^2);
+//   - Apply: the function computing the result; unary operators receive their operand as a and 0 as b.
+type Operator struct {
+	Precedence       int
+	RightAssociative bool
+	Apply            func(a, b float64) (float64, error)
+}
+
+// Function describes a callable function.
+//
+// Fields:
+//   - MinArgs: the minimum number of arguments;
+//   - MaxArgs: the maximum number of arguments, -1 means unbounded;
+//   - Apply: the function computing the result from its arguments.
+type Function struct {
+	MinArgs int
+	MaxArgs int
+	Apply   func(args []float64) (float64, error)
+}
+
+// BinaryOperators is the precedence and associativity table of binary operators.
+//
+//	||                  1  left
+//	&&                  2  left
+//	== !=               3  left
+//	< <= > >=           4  left
+//	+ -                 5  left
+//	* / %               6  left
+//	unary - + !         7  right (see UnaryOperators)
+//	^                   8  right
+var BinaryOperators = map[string]Operator{
+	"||": {Precedence: 1, Apply: func(a, b float64) (float64, error) { return boolToFloat(a != 0 || b != 0), nil }},
+	"&&": {Precedence: 2, Apply: func(a, b float64) (float64, error) { return boolToFloat(a != 0 && b != 0), nil }},
+	"==": {Precedence: 3, Apply: func(a, b float64) (float64, error) { return boolToFloat(a == b), nil }},
+	"!=": {Precedence: 3, Apply: func(a, b float64) (float64, error) { return boolToFloat(a != b), nil }},
+	"<":  {Precedence: 4, Apply: func(a, b float64) (float64, error) { return boolToFloat(a < b), nil }},
+	"<=": {Precedence: 4, Apply: func(a, b float64) (float64, error) { return boolToFloat(a <= b), nil }},
+	">":  {Precedence: 4, Apply: func(a, b float64) (float64, error) { return boolToFloat(a > b), nil }},
+	">=": {Precedence: 4, Apply: func(a, b float64) (float64, error) { return boolToFloat(a >= b), nil }},
+	"+":  {Precedence: 5, Apply: func(a, b float64) (float64, error) { return a + b, nil }},
+	"-":  {Precedence: 5, Apply: func(a, b float64) (float64, error) { return a - b, nil }},
+	"*":  {Precedence: 6, Apply: func(a, b float64) (float64, error) { return a * b, nil }},
+	"/": {Precedence: 6, Apply: func(a, b float64) (float64, error) {
+		if b == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return a / b, nil
+	}},
+	"%": {Precedence: 6, Apply: func(a, b float64) (float64, error) {
+		if b == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return math.Mod(a, b), nil
+	}},
+	"^": {Precedence: 8, RightAssociative: true, Apply: func(a, b float64) (float64, error) { return math.Pow(a, b), nil }},
+}
+
+// UnaryOperators is the table of prefix operators.
+//
+// They bind tighter than multiplication but looser than exponentiation, so -2^2 = -(2^2) = -4.
+var UnaryOperators = map[string]Operator{
+	"-": {Precedence: 7, RightAssociative: true, Apply: func(a, _ float64) (float64, error) { return -a, nil }},
+	"+": {Precedence: 7, RightAssociative: true, Apply: func(a, _ float64) (float64, error) { return a, nil }},
+	"!": {Precedence: 7, RightAssociative: true, Apply: func(a, _ float64) (float64, error) { return boolToFloat(a == 0), nil }},
+}
+
+// DefaultFunctions returns a new table with the built-in functions min, max, abs, gcd and lcm.
+//
+// gcd and lcm are backed by math_algo and require integer arguments of magnitude at most 2^53, the range
+// in which a float64 holds every integer exactly; lcm also fails if its result leaves that range.
+func DefaultFunctions() map[string]Function {
+	return map[string]Function{
+		"min": {MinArgs: 1, MaxArgs: -1, Apply: func(args []float64) (float64, error) {
+			result := args[0]
+			for _, v := range args[1:] {
+				result = math.Min(result, v)
+			}
+			return result, nil
+		}},
+		"max": {MinArgs: 1, MaxArgs: -1, Apply: func(args []float64) (float64, error) {
+			result := args[0]
+			for _, v := range args[1:] {
+				result = math.Max(result, v)
+			}
+			return result, nil
+		}},
+		"abs": {MinArgs: 1, MaxArgs: 1, Apply: func(args []float64) (float64, error) {
+			return math.Abs(args[0]), nil
+		}},
+		"gcd": {MinArgs: 2, MaxArgs: -1, Apply: func(args []float64) (float64, error) {
+			return foldIntegers(args, func(a, b int64) (int64, error) { return math_algo.GCD(a, b), nil })
+		}},
+		"lcm": {MinArgs: 2, MaxArgs: -1, Apply: func(args []float64) (float64, error) {
+			return foldIntegers(args, checkedLCM)
+		}},
+	}
+}
+
+// maxExactInteger is 2^53, the largest magnitude up to which a float64 represents every integer exactly.
+const maxExactInteger = 1 << 53
+
+// foldIntegers converts the arguments to integers and folds them with f.
+//
+// Returns ErrInvalidArgument if any argument is not a whole number or its magnitude exceeds maxExactInteger,
+// and the error of f if it fails.
+func foldIntegers(args []float64, f func(a, b int64) (int64, error)) (float64, error) {
+	var result int64
+
+	for i, v := range args {
+		if v != math.Trunc(v) || math.Abs(v) > maxExactInteger {
+			return 0, ErrInvalidArgument
+		}
+
+		if i == 0 {
+			result = int64(v)
+			continue
+		}
+
+		var err error
+		if result, err = f(result, int64(v)); err != nil {
+			return 0, err
+		}
+	}
+
+	return float64(result), nil
+}
+
+// checkedLCM returns the least common multiple of a and b, whose magnitudes are at most maxExactInteger.
+//
+// Returns ErrInvalidArgument if the result exceeds maxExactInteger.
+func checkedLCM(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	a /= math_algo.GCD(a, b)
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+
+	if a > maxExactInteger/b {
+		return 0, ErrInvalidArgument
+	}
+
+	return a * b, nil
+}
+
+// boolToFloat converts a boolean to 1 or 0.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,370 @@
+package expression
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures"
+)
+
+// Parser converts infix expressions to postfix notation and evaluates them.
+//
+// Fields:
+//   - Functions: the table of callable functions, looked up by name.
+type Parser struct {
+	Functions map[string]Function
+}
+
+// NewParser creates a new Parser with the built-in functions from DefaultFunctions.
+//
+// Returns a pointer to the new Parser.
+func NewParser() *Parser {
+	return &Parser{Functions: DefaultFunctions()}
+}
+
+// parenFrame tracks an open parenthesis on the shunting-yard stack.
+//
+// Fields:
+//   - function: the function token the parenthesis belongs to, nil for a grouping parenthesis;
+//   - args: the number of arguments seen so far in a function call.
+type parenFrame struct {
+	function *Token
+	args     int
+}
+
+// ToPostfix converts an infix expression to postfix (reverse Polish) notation using the shunting-yard algorithm.
+//
+// Operators are ordered by BinaryOperators and UnaryOperators; a "-", "+" or "!" is treated as unary
+// when it appears where an operand is expected. An identifier followed by "(" is a function call and
+// is emitted after its arguments with ArgCount set.
+//
+// Parameters:
+//   - src: the infix expression.
+//
+// Returns the tokens in postfix order and an *Error carrying the column of the problem.
+func (p *Parser) ToPostfix(src string) ([]Token, error) {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, newError(1, ErrEmptyExpression, "")
+	}
+
+	output := make([]Token, 0, len(tokens))
+	operators := data_structures.NewStack[Token](len(tokens))
+	parens := data_structures.NewStack[parenFrame](0)
+	expectOperand := true
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch token.Kind {
+		case TokenNumber:
+			if !expectOperand {
+				return nil, unexpected(token)
+			}
+
+			output = append(output, token)
+			expectOperand = false
+		case TokenVariable:
+			if !expectOperand {
+				return nil, unexpected(token)
+			}
+
+			if i+1 < len(tokens) && tokens[i+1].Kind == TokenLeftParen {
+				if _, ok := p.Functions[token.Text]; !ok {
+					return nil, newError(token.Column, ErrUnknownFunction, token.Text)
+				}
+
+				token.Kind = TokenFunction
+				operators.Push(token)
+
+				continue
+			}
+
+			output = append(output, token)
+			expectOperand = false
+		case TokenOperator:
+			if expectOperand {
+				if _, ok := UnaryOperators[token.Text]; !ok {
+					return nil, unexpected(token)
+				}
+
+				token.Kind = TokenUnaryOperator
+				operators.Push(token)
+
+				continue
+			}
+
+			current, ok := BinaryOperators[token.Text]
+			if !ok {
+				return nil, unexpected(token)
+			}
+
+			for {
+				top, err := operators.Peek()
+				if err != nil || !isOperatorToken(top) {
+					break
+				}
+
+				topPrecedence := operatorFor(top).Precedence
+				if topPrecedence < current.Precedence || (topPrecedence == current.Precedence && current.RightAssociative) {
+					break
+				}
+
+				output = append(output, top)
+				_, _ = operators.Pop()
+			}
+
+			operators.Push(token)
+			expectOperand = true
+		case TokenLeftParen:
+			if !expectOperand {
+				return nil, unexpected(token)
+			}
+
+			frame := parenFrame{}
+			if top, err := operators.Peek(); err == nil && top.Kind == TokenFunction && i > 0 && tokens[i-1].Kind == TokenVariable {
+				frame.function = &top
+			}
+
+			parens.Push(frame)
+			operators.Push(token)
+		case TokenComma:
+			frame, err := parens.Peek()
+			if err != nil || frame.function == nil {
+				return nil, unexpected(token)
+			}
+
+			if expectOperand {
+				return nil, unexpected(token)
+			}
+
+			output = popUntilParen(operators, output)
+			parens.Data[parens.Len()-1].args++
+			expectOperand = true
+		case TokenRightParen:
+			frame, err := parens.Pop()
+			if err != nil {
+				return nil, newError(token.Column, ErrMismatchedParens, "no matching '('")
+			}
+
+			emptyCall := frame.function != nil && frame.args == 0 && tokens[i-1].Kind == TokenLeftParen
+			if expectOperand && !emptyCall {
+				return nil, unexpected(token)
+			}
+
+			output = popUntilParen(operators, output)
+			_, _ = operators.Pop()
+
+			if frame.function != nil {
+				function, _ := operators.Pop()
+				function.ArgCount = frame.args
+				if !emptyCall {
+					function.ArgCount++
+				}
+
+				if err := p.checkArgCount(function); err != nil {
+					return nil, err
+				}
+
+				output = append(output, function)
+			}
+
+			expectOperand = false
+		}
+	}
+
+	if expectOperand {
+		return nil, newError(len([]rune(src))+1, ErrUnexpectedEnd, "")
+	}
+
+	for !operators.IsEmpty() {
+		top, _ := operators.Pop()
+		if top.Kind == TokenLeftParen {
+			return nil, newError(top.Column, ErrMismatchedParens, "unclosed '('")
+		}
+
+		output = append(output, top)
+	}
+
+	return output, nil
+}
+
+// EvalPostfix evaluates an expression in postfix notation.
+//
+// Parameters:
+//   - postfix: the tokens in postfix order, usually produced by ToPostfix;
+//   - vars: the values of the variables used in the expression.
+//
+// Returns the result and an *Error carrying the column of the token that failed.
+func (p *Parser) EvalPostfix(postfix []Token, vars map[string]float64) (float64, error) {
+	values := data_structures.NewStack[float64](len(postfix))
+
+	for _, token := range postfix {
+		switch token.Kind {
+		case TokenNumber:
+			values.Push(token.Value)
+		case TokenVariable:
+			value, ok := vars[token.Text]
+			if !ok {
+				return 0, newError(token.Column, ErrUnknownVariable, token.Text)
+			}
+
+			values.Push(value)
+		case TokenUnaryOperator, TokenOperator:
+			operator, ok := operatorLookup(token)
+			if !ok {
+				return 0, newError(token.Column, ErrMalformedPostfix, token.Text)
+			}
+
+			var a, b float64
+			var err error
+
+			if token.Kind == TokenOperator {
+				if b, err = values.Pop(); err != nil {
+					return 0, newError(token.Column, ErrMalformedPostfix, token.Text)
+				}
+			}
+
+			if a, err = values.Pop(); err != nil {
+				return 0, newError(token.Column, ErrMalformedPostfix, token.Text)
+			}
+
+			result, err := operator.Apply(a, b)
+			if err != nil {
+				return 0, newError(token.Column, err, token.Text)
+			}
+
+			values.Push(result)
+		case TokenFunction:
+			if err := p.checkArgCount(token); err != nil {
+				return 0, err
+			}
+
+			if values.Len() < token.ArgCount {
+				return 0, newError(token.Column, ErrMalformedPostfix, token.Text)
+			}
+
+			args := make([]float64, token.ArgCount)
+			copy(args, values.Data[values.Len()-token.ArgCount:])
+			values.Data = values.Data[:values.Len()-token.ArgCount]
+
+			result, err := p.Functions[token.Text].Apply(args)
+			if err != nil {
+				return 0, newError(token.Column, err, token.Text)
+			}
+
+			values.Push(result)
+		default:
+			return 0, newError(token.Column, ErrMalformedPostfix, token.Text)
+		}
+	}
+
+	if values.Len() != 1 {
+		return 0, newError(1, ErrMalformedPostfix, "")
+	}
+
+	result, _ := values.Pop()
+
+	return result, nil
+}
+
+// Evaluate parses and evaluates an infix expression.
+//
+// Parameters:
+//   - src: the infix expression;
+//   - vars: the values of the variables used in the expression.
+//
+// Returns the result and an *Error carrying the column of the problem.
+func (p *Parser) Evaluate(src string, vars map[string]float64) (float64, error) {
+	postfix, err := p.ToPostfix(src)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.EvalPostfix(postfix, vars)
+}
+
+// checkArgCount verifies that a function token refers to a known function with a valid number of arguments.
+func (p *Parser) checkArgCount(token Token) error {
+	function, ok := p.Functions[token.Text]
+	if !ok {
+		return newError(token.Column, ErrUnknownFunction, token.Text)
+	}
+
+	if token.ArgCount < function.MinArgs || (function.MaxArgs >= 0 && token.ArgCount > function.MaxArgs) {
+		return newError(token.Column, ErrWrongArgCount, token.Text)
+	}
+
+	return nil
+}
+
+// ToPostfix converts an infix expression to postfix notation with the built-in functions.
+func ToPostfix(src string) ([]Token, error) {
+	return NewParser().ToPostfix(src)
+}
+
+// Evaluate parses and evaluates an infix expression with the built-in functions.
+func Evaluate(src string, vars map[string]float64) (float64, error) {
+	return NewParser().Evaluate(src, vars)
+}
+
+// FormatPostfix joins postfix tokens with spaces, e.g. "3 4 2 * +".
+//
+// Functions are written with their argument count, e.g. "max/3".
+func FormatPostfix(postfix []Token) string {
+	parts := make([]string, len(postfix))
+	for i, token := range postfix {
+		parts[i] = token.String()
+		if token.Kind == TokenFunction {
+			parts[i] += "/" + strconv.Itoa(token.ArgCount)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// popUntilParen moves operators from the stack to the output until a left parenthesis is on top.
+func popUntilParen(operators *data_structures.Stack[Token], output []Token) []Token {
+	for {
+		top, err := operators.Peek()
+		if err != nil || top.Kind == TokenLeftParen {
+			return output
+		}
+
+		output = append(output, top)
+		_, _ = operators.Pop()
+	}
+}
+
+// isOperatorToken reports whether a token on the operator stack is a unary or binary operator.
+func isOperatorToken(token Token) bool {
+	return token.Kind == TokenOperator || token.Kind == TokenUnaryOperator
+}
+
+// operatorFor returns the table entry of an operator token that is known to be valid.
+func operatorFor(token Token) Operator {
+	operator, _ := operatorLookup(token)
+
+	return operator
+}
+
+// operatorLookup returns the table entry of an operator token.
+func operatorLookup(token Token) (Operator, bool) {
+	if token.Kind == TokenUnaryOperator {
+		operator, ok := UnaryOperators[token.Text]
+		return operator, ok
+	}
+
+	operator, ok := BinaryOperators[token.Text]
+
+	return operator, ok
+}
+
+// unexpected creates an ErrUnexpectedToken error for the given token.
+func unexpected(token Token) *Error {
+	return newError(token.Column, ErrUnexpectedToken, "'"+token.Text+"'")
+}
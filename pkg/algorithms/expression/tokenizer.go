@@ -0,0 +1,154 @@
+package expression
+
+import (
+	"strconv"
+	"unicode"
+)
+
+// TokenKind identifies the lexical class of a Token.
+type TokenKind int
+
+const (
+	TokenNumber TokenKind = iota
+	TokenVariable
+	TokenFunction
+	TokenOperator
+	TokenUnaryOperator
+	TokenLeftParen
+	TokenRightParen
+	TokenComma
+)
+
+// Token represents a lexical unit of an expression.
+//
+// Fields:
+//   - Kind: the lexical class of the token;
+//   - Text: the token as written in the source, e.g. "3.5", "x", "max" or "<=";
+//   - Value: the numeric value of a TokenNumber;
+//   - Column: the one-based column of the first character of the token;
+//   - ArgCount: the number of arguments of a TokenFunction, filled in by the shunting-yard conversion.
+type Token struct {
+	Kind     TokenKind
+	Text     string
+	Value    float64
+	Column   int
+	ArgCount int
+}
+
+// String returns the token as it appears in postfix notation.
+//
+// Unary operators are prefixed with "u" so that e.g. negation ("u-") is distinguishable from subtraction.
+func (t Token) String() string {
+	if t.Kind == TokenUnaryOperator {
+		return "u" + t.Text
+	}
+
+	return t.Text
+}
+
+// twoCharOperators lists the operators made of two characters; they are matched before single-character ones.
+var twoCharOperators = map[string]bool{
+	"&&": true, "||": true, "==": true, "!=": true, "<=": true, ">=": true,
+}
+
+// Tokenize splits an expression into tokens.
+//
+// The tokenizer only classifies characters; whether a "-" is unary or binary and
+// whether an identifier is a function call is decided by ToPostfix.
+//
+// Parameters:
+//   - src: the source expression.
+//
+// Returns the tokens and an *Error carrying the column of the first invalid character.
+func Tokenize(src string) ([]Token, error) {
+	var tokens []Token
+	runes := []rune(src)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		column := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i = scanNumber(runes, i)
+
+			text := string(runes[start:i])
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, newError(column, ErrInvalidNumber, text)
+			}
+
+			tokens = append(tokens, Token{Kind: TokenNumber, Text: text, Value: value, Column: column})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+
+			tokens = append(tokens, Token{Kind: TokenVariable, Text: string(runes[start:i]), Column: column})
+		case r == '(':
+			tokens = append(tokens, Token{Kind: TokenLeftParen, Text: "(", Column: column})
+			i++
+		case r == ')':
+			tokens = append(tokens, Token{Kind: TokenRightParen, Text: ")", Column: column})
+			i++
+		case r == ',':
+			tokens = append(tokens, Token{Kind: TokenComma, Text: ",", Column: column})
+			i++
+		default:
+			if i+1 < len(runes) && twoCharOperators[string(runes[i:i+2])] {
+				tokens = append(tokens, Token{Kind: TokenOperator, Text: string(runes[i : i+2]), Column: column})
+				i += 2
+
+				continue
+			}
+
+			text := string(r)
+			if _, ok := BinaryOperators[text]; !ok {
+				if _, ok := UnaryOperators[text]; !ok {
+					return nil, newError(column, ErrUnexpectedChar, strconv.QuoteRune(r))
+				}
+			}
+
+			tokens = append(tokens, Token{Kind: TokenOperator, Text: text, Column: column})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// scanNumber returns the index just past the number literal starting at i.
+//
+// A number is a run of digits with an optional fractional part and an optional exponent.
+func scanNumber(runes []rune, i int) int {
+	for i < len(runes) && unicode.IsDigit(runes[i]) {
+		i++
+	}
+
+	if i < len(runes) && runes[i] == '.' {
+		i++
+		for i < len(runes) && unicode.IsDigit(runes[i]) {
+			i++
+		}
+	}
+
+	if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+		j := i + 1
+		if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+			j++
+		}
+
+		if j < len(runes) && unicode.IsDigit(runes[j]) {
+			i = j
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+		}
+	}
+
+	return i
+}
@@ -1 +1,45 @@
 package math_algo
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// abs returns the absolute value of an integer.
+func abs[T Integer](a T) T {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
+
+// GCD returns the greatest common divisor of a and b using the Euclidean algorithm.
+//
+// The remainders are taken on the signed values, whose magnitudes they share, and the sign is dropped
+// only at the end, so the minimum value of a signed type is handled as well: GCD(math.MinInt64, 6) is 2.
+//
+// The result is non-negative; GCD(0, 0) is 0. The only exception is a divisor of -MinT, which does not
+// fit in the type: GCD(MinT, 0) and GCD(MinT, MinT) return MinT.
+//
+// Runs in O(log min(a, b)) time.
+func GCD[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return abs(a)
+}
+
+// LCM returns the least common multiple of a and b.
+//
+// The result is non-negative; LCM is 0 if either argument is 0. The product is not checked for
+// overflow: if the least common multiple does not fit in T, the result wraps around.
+func LCM[T Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return abs(a / GCD(a, b) * b)
+}
@@ -1 +1,236 @@
 package search
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Search returns the smallest index i in [0, n) at which pred(i) is true, or n if there is none.
+//
+// pred must be monotonic: false for a (possibly empty) prefix of [0, n) and true for the rest.
+// It is called O(log n) times, only with indices in [0, n).
+//
+// Parameters:
+//   - n: the size of the domain;
+//   - pred: the monotonic predicate.
+func Search(n int, pred func(i int) bool) int {
+	lo, hi := 0, n
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return lo
+}
+
+// SearchInt returns the smallest x in [lo, hi) at which pred(x) is true, or hi if there is none.
+//
+// It is Search over an arbitrary integer domain, for "binary search the answer" problems. The midpoint
+// is computed without overflow, so the domain may span the whole range of T.
+//
+// Parameters:
+//   - lo, hi: the bounds of the domain; an empty domain (lo >= hi) returns hi;
+//   - pred: the monotonic predicate.
+func SearchInt[T Integer](lo, hi T, pred func(x T) bool) T {
+	if lo >= hi {
+		return hi
+	}
+
+	for lo < hi {
+		// Floor of (lo+hi)/2 computed bitwise; it is correct for signed and unsigned T.
+		mid := (lo & hi) + (lo^hi)>>1
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return lo
+}
+
+// SearchFloat returns the smallest x in [lo, hi] at which pred(x) is true, to within tolerance.
+//
+// 1. Check that pred(hi) is true, so the interval contains a solution;
+//
+// 2. Bisect the interval, keeping pred false at the lower bound (unless it is lo) and true at the upper one;
+//
+// 3. Stop when the interval is not longer than tolerance, after maxIterations bisections, or when
+// the floating-point numbers between the bounds are exhausted, so a zero tolerance yields the
+// exact boundary up to the precision of F.
+//
+// Parameters:
+//   - lo, hi: the finite bounds of the interval, lo <= hi;
+//   - pred: the monotonic predicate, false below the answer and true from it on;
+//   - tolerance: the accepted length of the final interval, non-negative;
+//   - maxIterations: the maximum number of bisections; no limit when not positive.
+//
+// Returns the upper bound of the final interval, at which pred is true, ErrInvalidInterval or
+// ErrInvalidTolerance for invalid arguments and ErrNoSolution if pred(hi) is false.
+func SearchFloat[F Float](lo, hi F, pred func(x F) bool, tolerance F, maxIterations int) (F, error) {
+	if !isFinite(lo) || !isFinite(hi) || lo > hi {
+		return 0, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, lo, hi)
+	}
+
+	if !(tolerance >= 0) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidTolerance, tolerance)
+	}
+
+	if !pred(hi) {
+		return hi, ErrNoSolution
+	}
+
+	for i := 0; (maxIterations <= 0 || i < maxIterations) && hi-lo > tolerance; i++ {
+		// Halving both bounds first keeps the midpoint finite for intervals wider than the largest F.
+		mid := lo/2 + hi/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return hi, nil
+}
+
+// isFinite reports whether x is neither infinite nor NaN.
+func isFinite[F Float](x F) bool {
+	return !math.IsInf(float64(x), 0) && !math.IsNaN(float64(x))
+}
+
+// LowerBound returns the index of the first element of a sorted slice that is not less than target,
+// or len(data) if there is none. It is the position at which target can be inserted while keeping
+// the slice sorted, before any equal elements.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+func LowerBound[T cmp.Ordered](data []T, target T) int {
+	return LowerBoundFunc(data, target, cmp.Less[T])
+}
+
+// LowerBoundFunc returns the index of the first element of a slice sorted by less that is not less
+// than target, or len(data) if there is none.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+func LowerBoundFunc[T any](data []T, target T, less func(a, b T) bool) int {
+	return Search(len(data), func(i int) bool { return !less(data[i], target) })
+}
+
+// UpperBound returns the index of the first element of a sorted slice that is greater than target,
+// or len(data) if there is none. It is the position at which target can be inserted while keeping
+// the slice sorted, after any equal elements.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+func UpperBound[T cmp.Ordered](data []T, target T) int {
+	return UpperBoundFunc(data, target, cmp.Less[T])
+}
+
+// UpperBoundFunc returns the index of the first element of a slice sorted by less that is greater
+// than target, or len(data) if there is none.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+func UpperBoundFunc[T any](data []T, target T, less func(a, b T) bool) int {
+	return Search(len(data), func(i int) bool { return less(target, data[i]) })
+}
+
+// EqualRange returns the bounds [lo, hi) of the elements of a sorted slice that are equal to target.
+// If there are none, lo == hi is the position at which target can be inserted.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+func EqualRange[T cmp.Ordered](data []T, target T) (int, int) {
+	return EqualRangeFunc(data, target, cmp.Less[T])
+}
+
+// EqualRangeFunc returns the bounds [lo, hi) of the elements of a slice sorted by less that are
+// equivalent to target, i.e. neither less nor greater than it.
+//
+// 1. Bisect until an element equivalent to target is found, which splits the range;
+//
+// 2. Find the lower bound left of it and the upper bound right of it, each in its own part only;
+//
+// 3. Runs in O(log n) time, with fewer comparisons than a separate LowerBound and UpperBound.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+func EqualRangeFunc[T any](data []T, target T, less func(a, b T) bool) (int, int) {
+	lo, hi := 0, len(data)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+
+		switch {
+		case less(data[mid], target):
+			lo = mid + 1
+		case less(target, data[mid]):
+			hi = mid
+		default:
+			return lo + LowerBoundFunc(data[lo:mid], target, less), mid + 1 + UpperBoundFunc(data[mid+1:hi], target, less)
+		}
+	}
+
+	return lo, lo
+}
+
+// BinarySearch searches for target in a sorted slice.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+//
+// Returns the index of the first element equal to target, or the position at which it can be inserted,
+// and whether it was found.
+func BinarySearch[T cmp.Ordered](data []T, target T) (int, bool) {
+	return BinarySearchFunc(data, target, cmp.Less[T])
+}
+
+// BinarySearchFunc searches for target in a slice sorted by less.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the index of the first element equivalent to target, or the position at which it can be
+// inserted, and whether it was found.
+func BinarySearchFunc[T any](data []T, target T, less func(a, b T) bool) (int, bool) {
+	i := LowerBoundFunc(data, target, less)
+
+	return i, i < len(data) && !less(target, data[i])
+}
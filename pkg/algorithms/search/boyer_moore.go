@@ -0,0 +1,156 @@
+package search
+
+import "io"
+
+// BoyerMoore is a pattern compiled for the Boyer-Moore algorithm.
+//
+// Fields:
+//   - Pattern: the pattern to search for;
+//   - BadCharacter: the last position of every byte value in Pattern, -1 for the absent ones;
+//   - GoodSuffix: the shift after a mismatch at position j-1, once Pattern[j:] has matched,
+//     which aligns the matched suffix with its previous occurrence in Pattern; GoodSuffix[0]
+//     is the shift after a full match.
+type BoyerMoore struct {
+	Pattern      []byte
+	BadCharacter [256]int
+	GoodSuffix   []int
+}
+
+// NewBoyerMoore compiles a pattern for the Boyer-Moore algorithm.
+//
+// 1. The bad character table stores the last position of every byte in the pattern;
+//
+// 2. The good suffix table is built from the borders of the pattern's suffixes, in the way
+// the prefix function is built for KMP: for every suffix, the shift to the nearest earlier
+// occurrence that is preceded by a different byte, or else to the longest prefix of the
+// pattern that is also a suffix of it.
+//
+// Parameters:
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns a pointer to the new BoyerMoore.
+func NewBoyerMoore[S Text](pattern S) *BoyerMoore {
+	m := len(pattern)
+	b := &BoyerMoore{Pattern: cloneBytes(pattern), GoodSuffix: make([]int, m+1)}
+
+	for i := range b.BadCharacter {
+		b.BadCharacter[i] = -1
+	}
+
+	for i := 0; i < m; i++ {
+		b.BadCharacter[pattern[i]] = i
+	}
+
+	// border[i] is the start of the widest border of the suffix pattern[i:].
+	border := make([]int, m+1)
+
+	i, j := m, m+1
+	border[i] = j
+
+	for i > 0 {
+		for j <= m && pattern[i-1] != pattern[j-1] {
+			if b.GoodSuffix[j] == 0 {
+				b.GoodSuffix[j] = j - i
+			}
+
+			j = border[j]
+		}
+
+		i--
+		j--
+		border[i] = j
+	}
+
+	// The suffixes without an earlier occurrence shift to the widest border of the whole pattern.
+	j = border[0]
+	for i := 0; i <= m; i++ {
+		if b.GoodSuffix[i] == 0 {
+			b.GoodSuffix[i] = j
+		}
+
+		if i == j {
+			j = border[j]
+		}
+	}
+
+	return b
+}
+
+// BoyerMooreSearch returns the positions of all occurrences of pattern in text in ascending order,
+// overlapping ones included, with the Boyer-Moore algorithm.
+//
+// Parameters:
+//   - text: the text to search;
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns the positions, nil if there are none.
+func BoyerMooreSearch[S Text](text, pattern S) []int {
+	b := NewBoyerMoore(pattern)
+
+	return collectPositions(func(yield func(int) bool) { boyerMooreFind(b, text, yield) })
+}
+
+// FindAll returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (b *BoyerMoore) FindAll(text []byte) []int {
+	return collectPositions(func(yield func(int) bool) { boyerMooreFind(b, text, yield) })
+}
+
+// FindAllString returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (b *BoyerMoore) FindAllString(text string) []int {
+	return collectPositions(func(yield func(int) bool) { boyerMooreFind(b, text, yield) })
+}
+
+// FindReader reports the offsets of all occurrences of the pattern in r in ascending order.
+//
+// The input is read in chunks of DefaultStreamBufferSize bytes, so memory use does not depend on its length.
+//
+// Parameters:
+//   - r: the input to search;
+//   - yield: called with the offset of every occurrence; the search stops when it returns false.
+//
+// Returns the first read error other than io.EOF.
+func (b *BoyerMoore) FindReader(r io.Reader, yield func(pos int64) bool) error {
+	return findReader(r, len(b.Pattern), func(window []byte, yield func(int) bool) { boyerMooreFind(b, window, yield) }, yield)
+}
+
+// boyerMooreFind calls yield with the position of every occurrence of the pattern of b in text until it returns false.
+//
+// The pattern is compared from right to left. After a mismatch it is shifted by the larger of the
+// two rules: the bad character rule aligns the mismatched text byte with its last occurrence in the
+// pattern, the good suffix rule aligns the matched suffix with its previous occurrence. On natural
+// text most shifts skip close to the whole pattern, so long patterns are found in sublinear time.
+func boyerMooreFind[T Text](b *BoyerMoore, text T, yield func(pos int) bool) {
+	m, n := len(b.Pattern), len(text)
+	if m == 0 {
+		return
+	}
+
+	for s := 0; s <= n-m; {
+		j := m - 1
+		for j >= 0 && b.Pattern[j] == text[s+j] {
+			j--
+		}
+
+		if j < 0 {
+			if !yield(s) {
+				return
+			}
+
+			s += b.GoodSuffix[0]
+
+			continue
+		}
+
+		s += max(b.GoodSuffix[j+1], j-b.BadCharacter[text[s+j]])
+	}
+}
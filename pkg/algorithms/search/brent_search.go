@@ -0,0 +1,173 @@
+package search
+
+import (
+	"fmt"
+	"math"
+)
+
+// BrentSearch finds the extremum of a unimodal function over the interval [lo, hi] with Brent's method.
+//
+// 1. Keep the best point x and the two next best points w and v found so far, and fit a parabola
+// through them; its vertex is the next point to evaluate;
+//
+// 2. The parabolic step is only accepted if it falls inside the interval and is shorter than half
+// the step before the last one, i.e. if it makes progress; otherwise fall back to a golden-section step;
+//
+// 3. Stop when x is within tolerance/3 plus sqrt(epsilon) times |x| of the interval midpoint, or after
+// maxIterations steps.
+//
+// Smooth functions are optimized with superlinear convergence, while the golden-section fallback keeps
+// the worst case close to that of GoldenSectionSearch. Differences of values close to the extremum are
+// dominated by rounding, so the argument is found up to about sqrt(epsilon) relative precision
+// even with a zero tolerance.
+//
+// Parameters:
+//   - lo, hi: the finite bounds of the interval, lo <= hi, with a finite length hi-lo;
+//   - f: the function to optimize;
+//   - tolerance: the accepted error of the argument, non-negative;
+//   - maxIterations: the maximum number of steps; no limit when not positive;
+//   - goal: whether to search for the minimum or the maximum.
+//
+// Returns the best of the evaluated points, and ErrInvalidInterval or ErrInvalidTolerance for invalid arguments.
+func BrentSearch[F Float](lo, hi F, f func(x F) F, tolerance F, maxIterations int, goal Goal) (Optimum[F, F], error) {
+	// The length of the interval must be finite as well, or every point computed from it overflows.
+	if !isFinite(lo) || !isFinite(hi) || lo > hi || !isFinite(hi-lo) {
+		return Optimum[F, F]{}, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, lo, hi)
+	}
+
+	if !(tolerance >= 0) {
+		return Optimum[F, F]{}, fmt.Errorf("%w: %v", ErrInvalidTolerance, tolerance)
+	}
+
+	// The search minimizes g; for Maximize g is -f, and the values are negated back at the end.
+	sign := F(1)
+	if goal == Maximize {
+		sign = -1
+	}
+
+	evaluations := 0
+	g := func(x F) F {
+		evaluations++
+		return sign * f(x)
+	}
+
+	epsilon := F(math.Sqrt(machineEpsilon[F]()))
+	a, b := lo, hi
+
+	x := a + (1-invPhi)*(b-a)
+	w, v := x, x
+	fx := g(x)
+	fw, fv := fx, fx
+
+	// d is the current step and e the one before it.
+	var d, e F
+
+	for i := 0; maxIterations <= 0 || i < maxIterations; i++ {
+		m := a/2 + b/2
+		tol1 := epsilon*abs(x) + tolerance/3 + epsilon*epsilon
+		tol2 := 2 * tol1
+
+		if abs(x-m) <= tol2-(b-a)/2 {
+			break
+		}
+
+		parabolic := false
+
+		if abs(e) > tol1 {
+			// The vertex of the parabola through x, w and v is x + p/q.
+			r := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+
+			if q > 0 {
+				p = -p
+			} else {
+				q = -q
+			}
+
+			if abs(p) < abs(q*e/2) && p > q*(a-x) && p < q*(b-x) {
+				e, d = d, p/q
+				parabolic = true
+
+				// Do not evaluate g too close to the bounds.
+				if u := x + d; u-a < tol2 || b-u < tol2 {
+					d = tol1
+					if x >= m {
+						d = -tol1
+					}
+				}
+			}
+		}
+
+		if !parabolic {
+			if x < m {
+				e = b - x
+			} else {
+				e = a - x
+			}
+
+			d = (1 - invPhi) * e
+		}
+
+		// Points closer than tol1 to x cannot be told apart from it.
+		u := x + d
+		if abs(d) < tol1 {
+			if d >= 0 {
+				u = x + tol1
+			} else {
+				u = x - tol1
+			}
+		}
+
+		fu := g(u)
+
+		if fu <= fx {
+			if u < x {
+				b = x
+			} else {
+				a = x
+			}
+
+			v, fv = w, fw
+			w, fw = x, fx
+			x, fx = u, fu
+
+			continue
+		}
+
+		if u < x {
+			a = u
+		} else {
+			b = u
+		}
+
+		if fu <= fw || w == x {
+			v, fv = w, fw
+			w, fw = u, fu
+		} else if fu <= fv || v == x || v == w {
+			v, fv = u, fu
+		}
+	}
+
+	return Optimum[F, F]{Arg: x, Value: sign * fx, Evaluations: evaluations}, nil
+}
+
+// machineEpsilon returns the difference between 1 and the next larger value of F.
+func machineEpsilon[F Float]() float64 {
+	// 2^-30 is lost when added to 1 in single precision only.
+	if F(1)+F(0x1p-30) == 1 {
+		return 0x1p-23
+	}
+
+	return 0x1p-52
+}
+
+// abs returns the absolute value of x.
+func abs[F Float](x F) F {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
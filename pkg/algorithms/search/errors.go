@@ -0,0 +1,11 @@
+package search
+
+import "errors"
+
+var (
+	ErrInvalidInterval = errors.New("invalid search interval")
+
+	ErrInvalidTolerance = errors.New("tolerance must be a non-negative number")
+
+	ErrNoSolution = errors.New("predicate is false on the whole interval")
+)
@@ -0,0 +1,94 @@
+package search
+
+import (
+	"cmp"
+	"math"
+)
+
+// ExponentialSearch searches for target in a sorted slice by galloping from the start.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+//
+// Returns the index of the first element equal to target, or the position at which it can be inserted,
+// and whether it was found.
+func ExponentialSearch[T cmp.Ordered](data []T, target T) (int, bool) {
+	return ExponentialSearchFunc(data, target, cmp.Less[T])
+}
+
+// ExponentialSearchFunc searches for target in a slice sorted by less by galloping from the start.
+//
+// 1. Compare target with the elements at indices 0, 1, 3, 7, ..., 2^k-1 until one is not less than it
+// or the slice ends;
+//
+// 2. The answer lies between the last two probes, so finish with a binary search there;
+//
+// 3. Runs in O(log p) time, where p is the position of the answer, which beats binary search when
+// the answer is near the start of a long slice.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the index of the first element equivalent to target, or the position at which it can be
+// inserted, and whether it was found.
+func ExponentialSearchFunc[T any](data []T, target T, less func(a, b T) bool) (int, bool) {
+	n := len(data)
+
+	i, _ := SearchUnbounded(func(i int) bool { return i >= n || !less(data[i], target) })
+
+	return i, i < n && !less(target, data[i])
+}
+
+// ExponentialSearchUnbounded searches for target in a sorted sequence of unknown length, such as a stream
+// that is read on demand or a file that is too large to size up front.
+//
+// Elements are only requested at the probed indices, O(log p) of them, where p is the position of the
+// answer. The sequence is treated as ending at the first index for which at reports false.
+//
+// Parameters:
+//   - at: returns the element at index i and true, or false if the sequence is shorter than i+1;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the index of the first element equivalent to target, or the length of the sequence if every
+// element is less than target, and whether it was found.
+func ExponentialSearchUnbounded[T any](at func(i int) (T, bool), target T, less func(a, b T) bool) (int, bool) {
+	i, _ := SearchUnbounded(func(i int) bool {
+		value, ok := at(i)
+		return !ok || !less(value, target)
+	})
+
+	value, ok := at(i)
+
+	return i, ok && !less(target, value)
+}
+
+// SearchUnbounded returns the smallest non-negative index at which pred is true, without an upper bound.
+//
+// 1. Gallop: evaluate pred at 0, 1, 3, 7, ..., 2^k-1 until it is true;
+//
+// 2. Bisect between the last false and the first true probe;
+//
+// 3. pred is called O(log i) times, where i is the result.
+//
+// Parameters:
+//   - pred: the monotonic predicate, false for a prefix of the non-negative integers and true for the rest.
+//
+// Returns the index and true, or math.MaxInt and false if pred is false up to math.MaxInt.
+func SearchUnbounded(pred func(i int) bool) (int, bool) {
+	lo, hi := 0, 0
+
+	for !pred(hi) {
+		if hi == math.MaxInt {
+			return math.MaxInt, false
+		}
+
+		// The probes 2^k-1 end exactly at math.MaxInt, so doubling never overflows.
+		lo, hi = hi+1, 2*hi+1
+	}
+
+	return lo + Search(hi-lo, func(i int) bool { return pred(lo + i) }), true
+}
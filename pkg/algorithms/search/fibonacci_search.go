@@ -0,0 +1,61 @@
+package search
+
+import "cmp"
+
+// FibonacciSearch searches for target in a sorted slice by splitting it at Fibonacci numbers.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+//
+// Returns the index of the first element equal to target, or the position at which it can be inserted,
+// and whether it was found.
+func FibonacciSearch[T cmp.Ordered](data []T, target T) (int, bool) {
+	return FibonacciSearchFunc(data, target, cmp.Less[T])
+}
+
+// FibonacciSearchFunc searches for target in a slice sorted by less by splitting it at Fibonacci numbers.
+//
+// 1. Cover the slice with an interval of F(k)-1 positions, where F(k) is the smallest Fibonacci number
+// greater than n; positions from n on behave as elements greater than everything else;
+//
+// 2. Probe the position F(k-1)-1 of the interval: if the element is not less than target the answer
+// lies in the left F(k-1)-1 positions or is the probe itself, otherwise in the right F(k-2)-1 positions;
+//
+// 3. The split points come from the previous Fibonacci numbers by subtraction, so no division is
+// needed. The intervals shrink by the golden ratio, about 1.44*log2(n) probes in the worst case,
+// and consecutive probes stay closer together than in a binary search, which helps on
+// storage where the cost of an access grows with the distance from the previous one.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the index of the first element equivalent to target, or the position at which it can be
+// inserted, and whether it was found.
+func FibonacciSearchFunc[T any](data []T, target T, less func(a, b T) bool) (int, bool) {
+	n := len(data)
+
+	// prev and fib are F(k-1) and F(k).
+	prev, fib := 1, 1
+	for fib <= n {
+		prev, fib = fib, prev+fib
+	}
+
+	// The answer is result unless a probe in [lo, lo+fib-1) finds an earlier position.
+	lo, result := 0, n
+	for fib > 1 {
+		i := lo + prev - 1
+
+		if i >= n || !less(data[i], target) {
+			result = min(result, i)
+			prev, fib = fib-prev, prev
+		} else {
+			lo = i + 1
+			prev, fib = 2*prev-fib, fib-prev
+		}
+	}
+
+	return result, result < n && !less(target, data[result])
+}
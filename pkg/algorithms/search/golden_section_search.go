@@ -0,0 +1,77 @@
+package search
+
+import "fmt"
+
+// invPhi is 1/phi = phi-1, the factor by which golden-section search shrinks the interval at every step.
+const invPhi = 0.6180339887498949
+
+// GoldenSectionSearch finds the extremum of a unimodal function over the interval [lo, hi].
+//
+// 1. Evaluate f at the two points c and d that divide the interval in the golden ratio;
+//
+// 2. Drop the part beyond the worse of them. The remaining interval is 1/phi of the previous one and
+// the better point divides it in the golden ratio again, so every step needs one new evaluation only;
+//
+// 3. Stop when the interval is not longer than tolerance, after maxIterations steps, or when the
+// floating-point numbers inside the interval are exhausted, so a zero tolerance yields the
+// extremum up to the precision of F.
+//
+// f must be unimodal on [lo, hi] for goal. Every step removes 38% of the interval whatever f looks like;
+// for smooth functions BrentSearch usually needs far fewer evaluations.
+//
+// Parameters:
+//   - lo, hi: the finite bounds of the interval, lo <= hi, with a finite length hi-lo;
+//   - f: the function to optimize;
+//   - tolerance: the accepted length of the final interval, non-negative;
+//   - maxIterations: the maximum number of steps; no limit when not positive;
+//   - goal: whether to search for the minimum or the maximum.
+//
+// Returns the best of the evaluated points, and ErrInvalidInterval or ErrInvalidTolerance for invalid arguments.
+func GoldenSectionSearch[F Float](
+	lo, hi F, f func(x F) F, tolerance F, maxIterations int, goal Goal,
+) (Optimum[F, F], error) {
+	// The length of the interval must be finite as well, or every point computed from it overflows.
+	if !isFinite(lo) || !isFinite(hi) || lo > hi || !isFinite(hi-lo) {
+		return Optimum[F, F]{}, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, lo, hi)
+	}
+
+	if !(tolerance >= 0) {
+		return Optimum[F, F]{}, fmt.Errorf("%w: %v", ErrInvalidTolerance, tolerance)
+	}
+
+	evaluations := 0
+	eval := func(x F) F {
+		evaluations++
+		return f(x)
+	}
+
+	a, b := lo, hi
+	c, d := b-invPhi*(b-a), a+invPhi*(b-a)
+	fc, fd := eval(c), eval(d)
+
+	for i := 0; (maxIterations <= 0 || i < maxIterations) && b-a > tolerance; i++ {
+		if better(goal, fc, fd) {
+			next := d - invPhi*(d-a)
+			if next <= a || next >= c {
+				break
+			}
+
+			b, d, fd = d, c, fc
+			c, fc = next, eval(next)
+		} else {
+			next := c + invPhi*(b-c)
+			if next <= d || next >= b {
+				break
+			}
+
+			a, c, fc = c, d, fd
+			d, fd = next, eval(next)
+		}
+	}
+
+	if better(goal, fd, fc) {
+		c, fc = d, fd
+	}
+
+	return Optimum[F, F]{Arg: c, Value: fc, Evaluations: evaluations}, nil
+}
@@ -0,0 +1,77 @@
+package search
+
+import "cmp"
+
+// InterpolationSearch searches for target in a sorted slice of numbers by estimating its position
+// from the values at the ends of the search interval.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+//
+// Returns the index of the first element equal to target, or the position at which it can be inserted,
+// and whether it was found.
+func InterpolationSearch[T Number](data []T, target T) (int, bool) {
+	return InterpolationSearchBy(data, target, func(value T) T { return value })
+}
+
+// InterpolationSearchBy searches for an element with the numeric key target in a slice sorted by key.
+//
+// 1. While target lies strictly inside the keys at the ends of the interval, probe the position
+// interpolated linearly between them and shrink the interval to one side of the probe;
+//
+// 2. If a probe removes less than half of the interval, the next probe bisects it instead. Skewed
+// keys, such as exponentially growing ones, defeat interpolation, and the guard keeps the worst case
+// at O(log n) probes, about twice those of a binary search;
+//
+// 3. On uniformly distributed keys it needs O(log log n) probes on average.
+//
+// Keys are ordered as by cmp.Less, so NaN keys must come first.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order of key;
+//   - target: the key to search for;
+//   - key: returns the numeric key of an element.
+//
+// Returns the index of the first element with key target, or the position at which such an element
+// can be inserted, and whether it was found.
+func InterpolationSearchBy[T any, K Number](data []T, target K, key func(value T) K) (int, bool) {
+	lo, hi := 0, len(data)
+	bisect := false
+
+	// The answer stays within [lo, hi]: the keys before lo are less than target, the keys from hi on are not.
+	for lo < hi {
+		first, last := key(data[lo]), key(data[hi-1])
+
+		if !cmp.Less(first, target) {
+			hi = lo
+			break
+		}
+
+		if cmp.Less(last, target) {
+			lo = hi
+			break
+		}
+
+		size := hi - lo
+		pos := lo + size/2
+
+		if !bisect {
+			// first < target <= last here; the differences are taken in float64 so they cannot overflow K.
+			fraction := (float64(target) - float64(first)) / (float64(last) - float64(first))
+			if fraction >= 0 && fraction <= 1 {
+				pos = lo + int(fraction*float64(size-1))
+			}
+		}
+
+		if cmp.Less(key(data[pos]), target) {
+			lo = pos + 1
+		} else {
+			hi = pos
+		}
+
+		bisect = !bisect && 2*(hi-lo) > size
+	}
+
+	return lo, lo < len(data) && !cmp.Less(target, key(data[lo]))
+}
@@ -0,0 +1,53 @@
+package search
+
+import (
+	"cmp"
+	"math"
+)
+
+// JumpSearch searches for target in a sorted slice by jumping ahead in blocks of sqrt(n) elements.
+//
+// Parameters:
+//   - data: the slice, sorted in ascending order;
+//   - target: the value to search for.
+//
+// Returns the index of the first element equal to target, or the position at which it can be inserted,
+// and whether it was found.
+func JumpSearch[T cmp.Ordered](data []T, target T) (int, bool) {
+	return JumpSearchFunc(data, target, cmp.Less[T])
+}
+
+// JumpSearchFunc searches for target in a slice sorted by less by jumping ahead in blocks.
+//
+// 1. Compare target with the last element of consecutive blocks of sqrt(n) elements until one
+// is not less than it;
+//
+// 2. Scan that block linearly from its start;
+//
+// 3. Runs in O(sqrt n) time. It only ever moves forwards, apart from the single step back to the
+// start of the block, which suits data that is expensive to revisit, e.g. on tape-like storage or in
+// a cache that favours sequential access; on small slices it is competitive with binary search.
+//
+// Parameters:
+//   - data: the slice, sorted by less;
+//   - target: the value to search for;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the index of the first element equivalent to target, or the position at which it can be
+// inserted, and whether it was found.
+func JumpSearchFunc[T any](data []T, target T, less func(a, b T) bool) (int, bool) {
+	n := len(data)
+	step := max(int(math.Sqrt(float64(n))), 1)
+
+	lo := 0
+	for lo < n && less(data[min(lo+step, n)-1], target) {
+		lo = min(lo+step, n)
+	}
+
+	hi := min(lo+step, n)
+	for lo < hi && less(data[lo], target) {
+		lo++
+	}
+
+	return lo, lo < n && !less(target, data[lo])
+}
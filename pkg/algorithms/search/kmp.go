@@ -0,0 +1,132 @@
+package search
+
+import "io"
+
+// KMP is a pattern compiled for the Knuth-Morris-Pratt algorithm.
+//
+// Fields:
+//   - Pattern: the pattern to search for;
+//   - Prefix: the prefix function of Pattern.
+type KMP struct {
+	Pattern []byte
+	Prefix  []int
+}
+
+// NewKMP compiles a pattern for the Knuth-Morris-Pratt algorithm.
+//
+// Parameters:
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns a pointer to the new KMP.
+func NewKMP[S Text](pattern S) *KMP {
+	return &KMP{Pattern: cloneBytes(pattern), Prefix: PrefixFunction(pattern)}
+}
+
+// KMPSearch returns the positions of all occurrences of pattern in text in ascending order,
+// overlapping ones included, with the Knuth-Morris-Pratt algorithm.
+//
+// Parameters:
+//   - text: the text to search;
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns the positions, nil if there are none.
+func KMPSearch[S Text](text, pattern S) []int {
+	k := NewKMP(pattern)
+
+	return collectPositions(func(yield func(int) bool) { kmpFind(k, text, yield) })
+}
+
+// PrefixFunction returns the prefix function of s: the i-th value is the length of the longest proper
+// prefix of s[:i+1] that is also its suffix.
+//
+// 1. The candidate for position i is the value of position i-1 extended by one byte;
+//
+// 2. If the next byte does not match, fall back to the next shorter border, which is the prefix
+// function of the current candidate, until it matches or no border is left;
+//
+// 3. Every fallback shortens the candidate, and it only grows by one per position, so the whole
+// function is computed in O(n) time.
+//
+// Parameters:
+//   - s: the string or byte slice.
+func PrefixFunction[S Text](s S) []int {
+	prefix := make([]int, len(s))
+
+	for i := 1; i < len(s); i++ {
+		k := prefix[i-1]
+		for k > 0 && s[i] != s[k] {
+			k = prefix[k-1]
+		}
+
+		if s[i] == s[k] {
+			k++
+		}
+
+		prefix[i] = k
+	}
+
+	return prefix
+}
+
+// FindAll returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (k *KMP) FindAll(text []byte) []int {
+	return collectPositions(func(yield func(int) bool) { kmpFind(k, text, yield) })
+}
+
+// FindAllString returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (k *KMP) FindAllString(text string) []int {
+	return collectPositions(func(yield func(int) bool) { kmpFind(k, text, yield) })
+}
+
+// FindReader reports the offsets of all occurrences of the pattern in r in ascending order.
+//
+// The input is read in chunks of DefaultStreamBufferSize bytes, so memory use does not depend on its length.
+//
+// Parameters:
+//   - r: the input to search;
+//   - yield: called with the offset of every occurrence; the search stops when it returns false.
+//
+// Returns the first read error other than io.EOF.
+func (k *KMP) FindReader(r io.Reader, yield func(pos int64) bool) error {
+	return findReader(r, len(k.Pattern), func(window []byte, yield func(int) bool) { kmpFind(k, window, yield) }, yield)
+}
+
+// kmpFind calls yield with the position of every occurrence of the pattern of k in text until it returns false.
+//
+// The text is read once from left to right without ever stepping back, in O(n) time; after a mismatch
+// the prefix function tells how much of the pattern is still matched.
+func kmpFind[T Text](k *KMP, text T, yield func(pos int) bool) {
+	m := len(k.Pattern)
+	if m == 0 {
+		return
+	}
+
+	matched := 0
+	for i := 0; i < len(text); i++ {
+		for matched > 0 && text[i] != k.Pattern[matched] {
+			matched = k.Prefix[matched-1]
+		}
+
+		if text[i] == k.Pattern[matched] {
+			matched++
+		}
+
+		if matched == m {
+			if !yield(i - m + 1) {
+				return
+			}
+
+			matched = k.Prefix[m-1]
+		}
+	}
+}
@@ -1 +1,352 @@
 package search
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// parallelChunkSize is the number of elements a worker of ParallelIndexFunc claims at once.
+	parallelChunkSize = 1 << 12
+	// parallelCheckInterval is the number of elements a worker scans between two checks for cancellation.
+	parallelCheckInterval = 1 << 8
+)
+
+// Index returns the index of the first occurrence of target in data, or -1 if there is none.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - target: the value to find.
+func Index[T comparable](data []T, target T) int {
+	for i, value := range data {
+		if value == target {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IndexFunc returns the index of the first element of data satisfying pred, or -1 if there is none.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - pred: the predicate to satisfy.
+func IndexFunc[T any](data []T, pred func(value T) bool) int {
+	for i, value := range data {
+		if pred(value) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndex returns the index of the last occurrence of target in data, or -1 if there is none.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - target: the value to find.
+func LastIndex[T comparable](data []T, target T) int {
+	for i := len(data) - 1; i >= 0; i-- {
+		if data[i] == target {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexFunc returns the index of the last element of data satisfying pred, or -1 if there is none.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - pred: the predicate to satisfy.
+func LastIndexFunc[T any](data []T, pred func(value T) bool) int {
+	for i := len(data) - 1; i >= 0; i-- {
+		if pred(data[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindAll returns the indices of all elements of data satisfying pred in ascending order.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - pred: the predicate to satisfy.
+//
+// Returns the indices, nil if there are none.
+func FindAll[T any](data []T, pred func(value T) bool) []int {
+	var indices []int
+
+	for i, value := range data {
+		if pred(value) {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+// CountIf returns the number of elements of data satisfying pred.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - pred: the predicate to satisfy.
+func CountIf[T any](data []T, pred func(value T) bool) int {
+	count := 0
+
+	for _, value := range data {
+		if pred(value) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// IndexSentinel returns the index of the first occurrence of target in data, or -1 if there is none,
+// like Index, with the sentinel technique.
+//
+// 1. Save the last element and overwrite it with target, so the scan is guaranteed to stop
+// and the loop needs a single comparison per element instead of a value and a bounds check;
+//
+// 2. Restore the last element and tell a real match from the sentinel by its position.
+//
+// data is modified during the call, so it must not be accessed concurrently, even for reading.
+//
+// Parameters:
+//   - data: the slice to search;
+//   - target: the value to find.
+func IndexSentinel[T comparable](data []T, target T) int {
+	n := len(data)
+	if n == 0 {
+		return -1
+	}
+
+	// A value that is not equal to itself, such as NaN, would never stop the scan.
+	if target != target {
+		return -1
+	}
+
+	last := data[n-1]
+	data[n-1] = target
+
+	i := 0
+	for data[i] != target {
+		i++
+	}
+
+	data[n-1] = last
+
+	if i < n-1 || last == target {
+		return i
+	}
+
+	return -1
+}
+
+// IndexSeq returns the position of the first value produced by seq that satisfies pred, or -1 if there is none.
+//
+// seq is an iterator such as the All methods of the linked_lists and queues containers; it is stopped
+// at the first match.
+//
+// Parameters:
+//   - seq: the iterator to search;
+//   - pred: the predicate to satisfy.
+func IndexSeq[T any](seq func(yield func(T) bool), pred func(value T) bool) int {
+	found, i := -1, 0
+
+	seq(func(value T) bool {
+		if pred(value) {
+			found = i
+			return false
+		}
+
+		i++
+
+		return true
+	})
+
+	return found
+}
+
+// LastIndexSeq returns the position of the last value produced by seq that satisfies pred, or -1 if there is none.
+//
+// An iterator can only be read forwards, so seq is always consumed completely.
+//
+// Parameters:
+//   - seq: the iterator to search;
+//   - pred: the predicate to satisfy.
+func LastIndexSeq[T any](seq func(yield func(T) bool), pred func(value T) bool) int {
+	found, i := -1, 0
+
+	seq(func(value T) bool {
+		if pred(value) {
+			found = i
+		}
+
+		i++
+
+		return true
+	})
+
+	return found
+}
+
+// FindAllSeq returns the positions of all values produced by seq that satisfy pred in ascending order.
+//
+// Parameters:
+//   - seq: the iterator to search;
+//   - pred: the predicate to satisfy.
+//
+// Returns the positions, nil if there are none.
+func FindAllSeq[T any](seq func(yield func(T) bool), pred func(value T) bool) []int {
+	var positions []int
+	i := 0
+
+	seq(func(value T) bool {
+		if pred(value) {
+			positions = append(positions, i)
+		}
+
+		i++
+
+		return true
+	})
+
+	return positions
+}
+
+// CountIfSeq returns the number of values produced by seq that satisfy pred.
+//
+// Parameters:
+//   - seq: the iterator to search;
+//   - pred: the predicate to satisfy.
+func CountIfSeq[T any](seq func(yield func(T) bool), pred func(value T) bool) int {
+	count := 0
+
+	seq(func(value T) bool {
+		if pred(value) {
+			count++
+		}
+
+		return true
+	})
+
+	return count
+}
+
+// ParallelIndexFunc returns the index of the first element of data satisfying pred, or -1 if there is none,
+// scanning the slice with up to workers goroutines.
+//
+// 1. The workers claim chunks of 4096 elements in ascending order from a shared counter;
+//
+// 2. A worker that finds a match lowers the shared best index and cancels the search context,
+// so no more chunks are claimed. Chunks already claimed before the match are still scanned, but
+// only up to the best index, so the result is the first match and not just any match;
+//
+// 3. The workers check the parent context every 256 elements and stop once it is cancelled.
+//
+// pred is called concurrently and must be safe for concurrent use.
+//
+// Parameters:
+//   - ctx: cancels the search;
+//   - data: the slice to search;
+//   - pred: the predicate to satisfy;
+//   - workers: the maximum number of goroutines, GOMAXPROCS when not positive.
+//
+// Returns the index and nil, or -1 and the context error if ctx is cancelled before the search completes.
+func ParallelIndexFunc[T any](ctx context.Context, data []T, pred func(value T) bool, workers int) (int, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	n := len(data)
+	chunks := (n + parallelChunkSize - 1) / parallelChunkSize
+
+	searchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+		best atomic.Int64
+	)
+
+	best.Store(int64(n))
+
+	found := func(i int) {
+		for current := best.Load(); int64(i) < current; current = best.Load() {
+			if best.CompareAndSwap(current, int64(i)) {
+				break
+			}
+		}
+
+		cancel()
+	}
+
+	for w := 0; w < min(workers, chunks); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for searchCtx.Err() == nil {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= chunks {
+					return
+				}
+
+				if i := scanChunk(ctx, data, pred, chunk*parallelChunkSize, &best); i >= 0 {
+					found(i)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return -1, err
+	}
+
+	if i := int(best.Load()); i < n {
+		return i, nil
+	}
+
+	return -1, nil
+}
+
+// scanChunk scans the chunk of data starting at lo, up to the current best index.
+//
+// Returns the index of the first match in the chunk, or -1 if there is none before best
+// or ctx is cancelled.
+func scanChunk[T any](ctx context.Context, data []T, pred func(value T) bool, lo int, best *atomic.Int64) int {
+	hi := min(lo+parallelChunkSize, len(data))
+
+	for start := lo; start < hi; start += parallelCheckInterval {
+		if ctx.Err() != nil {
+			return -1
+		}
+
+		end := min(start+parallelCheckInterval, hi, int(best.Load()))
+		for i := start; i < end; i++ {
+			if pred(data[i]) {
+				return i
+			}
+		}
+
+		if end < min(start+parallelCheckInterval, hi) {
+			return -1
+		}
+	}
+
+	return -1
+}
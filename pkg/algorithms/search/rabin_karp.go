@@ -0,0 +1,304 @@
+package search
+
+import (
+	"io"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// rabinKarpModulus is the Mersenne prime 2^61-1, the modulus of the rolling hash.
+	rabinKarpModulus = 1<<61 - 1
+	// rabinKarpBase is the base of the rolling hash polynomial.
+	rabinKarpBase = 0x5bd1e995
+)
+
+// RabinKarp is a set of patterns compiled for the Rabin-Karp algorithm.
+//
+// Fields:
+//   - Patterns: the patterns to search for; empty patterns match nothing.
+type RabinKarp struct {
+	Patterns [][]byte
+
+	groups []rabinKarpGroup
+}
+
+// rabinKarpGroup holds the patterns of one length.
+//
+// Fields:
+//   - length: the length of the patterns;
+//   - power: base^(length-1), the weight of the byte that leaves the window;
+//   - patterns: the indices of the patterns by their hash;
+//   - single, singleHash: the only entry of patterns, if it has exactly one, nil otherwise.
+type rabinKarpGroup struct {
+	length     int
+	power      uint64
+	patterns   map[uint64][]int
+	single     []int
+	singleHash uint64
+}
+
+// NewRabinKarp compiles a set of patterns for the Rabin-Karp algorithm.
+//
+// The patterns are grouped by length; the text is scanned once, with one rolling hash per distinct
+// length, so the search takes O(n*L) time for L distinct lengths plus the verification of the
+// candidates whose hash matches, however many patterns there are.
+//
+// Parameters:
+//   - patterns: the patterns to search for.
+//
+// Returns a pointer to the new RabinKarp.
+func NewRabinKarp[S Text](patterns ...S) *RabinKarp {
+	rk := &RabinKarp{Patterns: make([][]byte, len(patterns))}
+	byLength := make(map[int]int)
+
+	for i, pattern := range patterns {
+		rk.Patterns[i] = cloneBytes(pattern)
+
+		m := len(pattern)
+		if m == 0 {
+			continue
+		}
+
+		g, ok := byLength[m]
+		if !ok {
+			g = len(rk.groups)
+			byLength[m] = g
+
+			rk.groups = append(rk.groups, rabinKarpGroup{
+				length:   m,
+				power:    powMod(rabinKarpBase, m-1),
+				patterns: make(map[uint64][]int),
+			})
+		}
+
+		h := rabinKarpHash(pattern)
+		rk.groups[g].patterns[h] = append(rk.groups[g].patterns[h], i)
+	}
+
+	for g := range rk.groups {
+		if group := &rk.groups[g]; len(group.patterns) == 1 {
+			for h, indices := range group.patterns {
+				group.single, group.singleHash = indices, h
+			}
+		}
+	}
+
+	slices.SortFunc(rk.groups, func(a, b rabinKarpGroup) int { return a.length - b.length })
+
+	return rk
+}
+
+// RabinKarpSearch returns the positions of all occurrences of pattern in text in ascending order,
+// overlapping ones included, with the Rabin-Karp algorithm.
+//
+// Parameters:
+//   - text: the text to search;
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns the positions, nil if there are none.
+func RabinKarpSearch[S Text](text, pattern S) []int {
+	rk := NewRabinKarp(pattern)
+
+	return collectPositions(func(yield func(int) bool) {
+		rabinKarpFind(rk, text, func(match Match) bool { return yield(match.Pos) })
+	})
+}
+
+// RabinKarpSearchAll returns all occurrences of several patterns in text with the Rabin-Karp algorithm.
+//
+// Parameters:
+//   - text: the text to search;
+//   - patterns: the patterns to search for; empty patterns match nothing.
+//
+// Returns the matches ordered by position and then by pattern index, nil if there are none.
+func RabinKarpSearchAll[S Text](text S, patterns ...S) []Match {
+	return collectMatches(NewRabinKarp(patterns...), text)
+}
+
+// FindAll returns all occurrences of the patterns in text.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the matches ordered by position and then by pattern index, nil if there are none.
+func (rk *RabinKarp) FindAll(text []byte) []Match {
+	return collectMatches(rk, text)
+}
+
+// FindAllString returns all occurrences of the patterns in text.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the matches ordered by position and then by pattern index, nil if there are none.
+func (rk *RabinKarp) FindAllString(text string) []Match {
+	return collectMatches(rk, text)
+}
+
+// FindReader reports all occurrences of the patterns in r, ordered by offset and then by pattern index.
+//
+// The input is read in chunks of DefaultStreamBufferSize bytes, so memory use does not depend on its length.
+//
+// Parameters:
+//   - r: the input to search;
+//   - yield: called with the offset and the pattern index of every occurrence; the search stops when it returns false.
+//
+// Returns the first read error other than io.EOF.
+func (rk *RabinKarp) FindReader(r io.Reader, yield func(pos int64, pattern int) bool) error {
+	longest := 0
+	if len(rk.groups) > 0 {
+		longest = rk.groups[len(rk.groups)-1].length
+	}
+
+	return scanReader(r, longest-1, func(window []byte, end int, offset int64) bool {
+		stopped := false
+
+		rabinKarpFind(rk, window, func(match Match) bool {
+			// The longest pattern may not fit after end yet, so every occurrence from there on, of any
+			// length, is reported with the next window to keep the order by offset.
+			if match.Pos >= end {
+				return false
+			}
+
+			stopped = !yield(offset+int64(match.Pos), match.Pattern)
+
+			return !stopped
+		})
+
+		return !stopped
+	})
+}
+
+// collectMatches returns the matches of rk in text in a slice, nil if there are none.
+func collectMatches[T Text](rk *RabinKarp, text T) []Match {
+	var matches []Match
+
+	rabinKarpFind(rk, text, func(match Match) bool {
+		matches = append(matches, match)
+		return true
+	})
+
+	return matches
+}
+
+// rabinKarpFind calls yield with every occurrence of the patterns of rk in text, ordered by position
+// and then by pattern index, until it returns false.
+//
+// Every window whose hash equals the hash of a pattern is compared with it byte by byte, so hash
+// collisions cost time but never produce false matches.
+func rabinKarpFind[T Text](rk *RabinKarp, text T, yield func(match Match) bool) {
+	n := len(text)
+
+	hashes := make([]uint64, len(rk.groups))
+	for g, group := range rk.groups {
+		if group.length <= n {
+			hashes[g] = rabinKarpHash(text[:group.length])
+		}
+	}
+
+	var found []int
+
+	for s := 0; s < n; s++ {
+		found = found[:0]
+
+		for g, group := range rk.groups {
+			// The groups are sorted by length, so the longer ones do not fit either.
+			if s+group.length > n {
+				break
+			}
+
+			// A map lookup at every position would dominate the search, so a single hash is compared directly.
+			var candidates []int
+			switch {
+			case group.single == nil:
+				candidates = group.patterns[hashes[g]]
+			case hashes[g] == group.singleHash:
+				candidates = group.single
+			}
+
+			for _, p := range candidates {
+				if equalAt(text, s, rk.Patterns[p]) {
+					found = append(found, p)
+				}
+			}
+
+			if s+group.length < n {
+				hashes[g] = rollHash(hashes[g], text[s], text[s+group.length], group.power)
+			}
+		}
+
+		if len(found) > 1 {
+			slices.Sort(found)
+		}
+
+		for _, p := range found {
+			if !yield(Match{Pos: s, Pattern: p}) {
+				return
+			}
+		}
+	}
+}
+
+// equalAt reports whether pattern occurs in text at position pos.
+func equalAt[T Text](text T, pos int, pattern []byte) bool {
+	for i := range pattern {
+		if text[pos+i] != pattern[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rabinKarpHash returns the polynomial hash of s modulo 2^61-1.
+func rabinKarpHash[S Text](s S) uint64 {
+	h := uint64(0)
+	for i := 0; i < len(s); i++ {
+		h = addMod(mulMod(h, rabinKarpBase), uint64(s[i]))
+	}
+
+	return h
+}
+
+// rollHash moves the window of hash h one byte to the right: out leaves the window and in enters it.
+func rollHash(h uint64, out, in byte, power uint64) uint64 {
+	h = addMod(h, rabinKarpModulus-mulMod(uint64(out), power))
+
+	return addMod(mulMod(h, rabinKarpBase), uint64(in))
+}
+
+// addMod returns (a + b) mod 2^61-1 for a, b < 2^61-1.
+func addMod(a, b uint64) uint64 {
+	sum := a + b
+	if sum >= rabinKarpModulus {
+		sum -= rabinKarpModulus
+	}
+
+	return sum
+}
+
+// mulMod returns (a * b) mod 2^61-1 for a, b < 2^61-1.
+//
+// The 122-bit product is split at bit 61; since 2^61 = 1 modulo 2^61-1, the high part is simply added to the low part.
+func mulMod(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	sum := (hi<<3 | lo>>61) + lo&rabinKarpModulus
+
+	return addMod(sum&rabinKarpModulus, sum>>61)
+}
+
+// powMod returns base^exp mod 2^61-1.
+func powMod(base uint64, exp int) uint64 {
+	result := uint64(1)
+
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulMod(result, base)
+		}
+
+		base = mulMod(base, base)
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package search
+
+import (
+	"errors"
+	"io"
+)
+
+// DefaultStreamBufferSize is the number of bytes the FindReader methods read from the input at once.
+const DefaultStreamBufferSize = 64 << 10
+
+// Text is a constraint that permits strings and byte slices.
+type Text interface {
+	~string | ~[]byte
+}
+
+// Match is an occurrence of one of several patterns in a text.
+//
+// Fields:
+//   - Pos: the offset of the first byte of the occurrence;
+//   - Pattern: the index of the pattern that occurs there.
+type Match struct {
+	Pos     int
+	Pattern int
+}
+
+// cloneBytes returns a copy of s that does not share memory with it.
+func cloneBytes[S Text](s S) []byte {
+	b := make([]byte, len(s))
+	copy(b, s)
+
+	return b
+}
+
+// collectPositions returns the positions produced by find in a slice, nil if there are none.
+func collectPositions(find func(yield func(pos int) bool)) []int {
+	var positions []int
+
+	find(func(pos int) bool {
+		positions = append(positions, pos)
+		return true
+	})
+
+	return positions
+}
+
+// findReader reports the occurrences of a pattern of length patternLen in r, using find to search
+// every window of the input, and calls yield with their offsets until it returns false.
+func findReader(r io.Reader, patternLen int, find func(window []byte, yield func(pos int) bool), yield func(pos int64) bool) error {
+	return scanReader(r, patternLen-1, func(window []byte, end int, offset int64) bool {
+		stopped := false
+
+		find(window, func(pos int) bool {
+			// The occurrences are found in ascending order, so the rest of the window is carried over as well.
+			if pos >= end {
+				return false
+			}
+
+			stopped = !yield(offset + int64(pos))
+
+			return !stopped
+		})
+
+		return !stopped
+	})
+}
+
+// scanReader reads r into a buffer of DefaultStreamBufferSize bytes plus overlap and calls scan with
+// every filled window until the input ends or scan returns false.
+//
+// Unless the input has ended, the window ends with overlap bytes that are carried over to the start of
+// the next one. scan receives the offset of the window in the input and end, the length of the window
+// without those bytes, and must only report the occurrences that start before end; the others are
+// reported with the next window. An occurrence of a pattern of up to overlap+1 bytes that starts before
+// end is fully inside the window, so every occurrence is reported exactly once and in the order of its
+// start, whatever its length. Memory use is bounded by the buffer, whatever the length of the input.
+func scanReader(r io.Reader, overlap int, scan func(window []byte, end int, offset int64) bool) error {
+	overlap = max(overlap, 0)
+	buf := make([]byte, max(DefaultStreamBufferSize, overlap)+overlap)
+
+	carry := 0
+	offset := int64(0)
+
+	for {
+		n, err := io.ReadFull(r, buf[carry:])
+		filled := carry + n
+
+		// A full buffer may be followed by more input, so its last overlap bytes wait for the next window.
+		end := filled
+		if err == nil {
+			end -= overlap
+		}
+
+		if filled > 0 && !scan(buf[:filled], end, offset) {
+			return nil
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		copy(buf, buf[end:filled])
+
+		offset += int64(end)
+		carry = filled - end
+	}
+}
@@ -0,0 +1,99 @@
+package search
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Goal selects whether an optimization searches for the minimum or the maximum of a function.
+type Goal int
+
+const (
+	// Minimize searches for the smallest value of the function.
+	Minimize Goal = iota
+	// Maximize searches for the largest value of the function.
+	Maximize
+)
+
+// Optimum is the result of a search for the extremum of a function.
+//
+// Fields:
+//   - Arg: the argument at which the extremum was found;
+//   - Value: the value of the function at Arg;
+//   - Evaluations: the number of times the function was called.
+type Optimum[X, V any] struct {
+	Arg         X
+	Value       V
+	Evaluations int
+}
+
+// better reports whether a is a strictly better value than b for goal.
+func better[V cmp.Ordered](goal Goal, a, b V) bool {
+	if goal == Maximize {
+		return cmp.Less(b, a)
+	}
+
+	return cmp.Less(a, b)
+}
+
+// TernarySearch finds the extremum of a unimodal function over the integers in [lo, hi].
+//
+// 1. Evaluate f at the points m1 and m2 one third of the interval in from each end. If f(m1) is
+// better, the extremum is not right of m2; if f(m2) is better, it is not left of m1; if they are
+// equal, it lies between them;
+//
+// 2. Repeat while the interval holds more than three points, then check the remaining points;
+//
+// 3. Takes about 2*log_1.5(n) evaluations. The thirds are computed without overflow, so the interval
+// may span the whole range of T.
+//
+// f must be unimodal for goal: for Minimize, strictly decreasing and then strictly increasing, with
+// a flat stretch allowed only at the minimum itself; for Maximize, the other way round.
+//
+// Parameters:
+//   - lo, hi: the bounds of the interval, both included, lo <= hi;
+//   - f: the function to optimize;
+//   - goal: whether to search for the minimum or the maximum.
+//
+// Returns the optimum, which may be any point of a flat extremum, and ErrInvalidInterval if lo > hi.
+func TernarySearch[T Integer, V cmp.Ordered](lo, hi T, f func(x T) V, goal Goal) (Optimum[T, V], error) {
+	if lo > hi {
+		return Optimum[T, V]{}, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, lo, hi)
+	}
+
+	evaluations := 0
+	eval := func(x T) V {
+		evaluations++
+		return f(x)
+	}
+
+	// The difference is exact in uint64 arithmetic for every integer type, signed or not.
+	for span := uint64(hi) - uint64(lo); span >= 3; span = uint64(hi) - uint64(lo) {
+		third := T(span / 3)
+		m1, m2 := lo+third, hi-third
+
+		f1, f2 := eval(m1), eval(m2)
+
+		switch {
+		case better(goal, f1, f2):
+			hi = m2 - 1
+		case better(goal, f2, f1):
+			lo = m1 + 1
+		default:
+			lo, hi = m1, m2
+		}
+	}
+
+	best := Optimum[T, V]{Arg: lo, Value: eval(lo)}
+	for x := lo; x < hi; {
+		x++
+
+		if value := eval(x); better(goal, value, best.Value) {
+			best.Arg, best.Value = x, value
+		}
+	}
+
+	best.Evaluations = evaluations
+
+	return best, nil
+}
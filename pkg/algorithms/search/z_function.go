@@ -0,0 +1,144 @@
+package search
+
+import "io"
+
+// ZAlgorithm is a pattern compiled for the Z-algorithm.
+//
+// Fields:
+//   - Pattern: the pattern to search for;
+//   - Z: the Z-function of Pattern.
+type ZAlgorithm struct {
+	Pattern []byte
+	Z       []int
+}
+
+// NewZAlgorithm compiles a pattern for the Z-algorithm.
+//
+// Parameters:
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns a pointer to the new ZAlgorithm.
+func NewZAlgorithm[S Text](pattern S) *ZAlgorithm {
+	return &ZAlgorithm{Pattern: cloneBytes(pattern), Z: ZFunction(pattern)}
+}
+
+// ZSearch returns the positions of all occurrences of pattern in text in ascending order,
+// overlapping ones included, with the Z-algorithm.
+//
+// Parameters:
+//   - text: the text to search;
+//   - pattern: the pattern to search for; an empty pattern matches nothing.
+//
+// Returns the positions, nil if there are none.
+func ZSearch[S Text](text, pattern S) []int {
+	z := NewZAlgorithm(pattern)
+
+	return collectPositions(func(yield func(int) bool) { zFind(z, text, yield) })
+}
+
+// ZFunction returns the Z-function of s: the i-th value is the length of the longest common prefix
+// of s and s[i:]. The 0-th value is len(s).
+//
+// 1. Keep the window [l, r) with the largest r such that s[l:r] is a prefix of s;
+//
+// 2. For i inside the window, s[i:r] equals s[i-l:r-l], so the value at i-l gives a lower bound
+// that is exact unless it reaches r;
+//
+// 3. Only then compare bytes beyond r, which moves r to the right, so the function is computed in O(n) time.
+//
+// Parameters:
+//   - s: the string or byte slice.
+func ZFunction[S Text](s S) []int {
+	n := len(s)
+	z := make([]int, n)
+	if n == 0 {
+		return z
+	}
+
+	z[0] = n
+
+	for i, l, r := 1, 0, 0; i < n; i++ {
+		k := 0
+		if i < r {
+			k = min(z[i-l], r-i)
+		}
+
+		for i+k < n && s[k] == s[i+k] {
+			k++
+		}
+
+		if i+k > r {
+			l, r = i, i+k
+		}
+
+		z[i] = k
+	}
+
+	return z
+}
+
+// FindAll returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (z *ZAlgorithm) FindAll(text []byte) []int {
+	return collectPositions(func(yield func(int) bool) { zFind(z, text, yield) })
+}
+
+// FindAllString returns the positions of all occurrences of the pattern in text in ascending order.
+//
+// Parameters:
+//   - text: the text to search.
+//
+// Returns the positions, nil if there are none.
+func (z *ZAlgorithm) FindAllString(text string) []int {
+	return collectPositions(func(yield func(int) bool) { zFind(z, text, yield) })
+}
+
+// FindReader reports the offsets of all occurrences of the pattern in r in ascending order.
+//
+// The input is read in chunks of DefaultStreamBufferSize bytes, so memory use does not depend on its length.
+//
+// Parameters:
+//   - r: the input to search;
+//   - yield: called with the offset of every occurrence; the search stops when it returns false.
+//
+// Returns the first read error other than io.EOF.
+func (z *ZAlgorithm) FindReader(r io.Reader, yield func(pos int64) bool) error {
+	return findReader(r, len(z.Pattern), func(window []byte, yield func(int) bool) { zFind(z, window, yield) }, yield)
+}
+
+// zFind calls yield with the position of every occurrence of the pattern of z in text until it returns false.
+//
+// It computes the Z-function of pattern+text restricted to the text, capped at the length of the pattern,
+// without building the concatenation: the window [l, r) now marks the text that matches a prefix of the
+// pattern, and the Z-function of the pattern supplies the values inside it.
+func zFind[T Text](z *ZAlgorithm, text T, yield func(pos int) bool) {
+	m, n := len(z.Pattern), len(text)
+	if m == 0 {
+		return
+	}
+
+	for i, l, r := 0, 0, 0; i < n; i++ {
+		k := 0
+		if i < r {
+			k = min(z.Z[i-l], r-i)
+		}
+
+		if i+k >= r {
+			for k < m && i+k < n && text[i+k] == z.Pattern[k] {
+				k++
+			}
+
+			if i+k > r {
+				l, r = i, i+k
+			}
+		}
+
+		if k == m && !yield(i) {
+			return
+		}
+	}
+}
@@ -1 +1,151 @@
 package sort
+
+import "cmp"
+
+// combShrinkFactor is the gap shrink factor of comb sort, scaled by 10 (a factor of 1.3).
+const combShrinkFactor = 13
+
+// BubbleSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func BubbleSort[T cmp.Ordered](data []T) {
+	BubbleSortFunc(data, cmp.Less[T])
+}
+
+// BubbleSortFunc sorts a slice in place with bubble sort using the order defined by less.
+//
+// 1. Each pass swaps neighbouring elements that are out of order, moving the greatest
+// unsorted element to the end;
+//
+// 2. Everything after the last swap of a pass is already sorted, so the next pass stops there;
+//
+// 3. A pass without swaps ends the sort, so sorted input takes a single O(n) pass;
+//
+// 4. Runs in O(n^2) worst-case time and O(1) extra space. The sort is stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func BubbleSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).bubbleSort()
+}
+
+// bubbleSort sorts the whole slice with bubble sort.
+func (s *sorter[T]) bubbleSort() {
+	for end := len(s.data); end > 1; {
+		lastSwap := 0
+
+		for i := 1; i < end; i++ {
+			if s.lessAt(i, i-1) {
+				s.swap(i, i-1)
+				lastSwap = i
+			}
+		}
+
+		end = lastSwap
+	}
+}
+
+// CocktailShakerSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func CocktailShakerSort[T cmp.Ordered](data []T) {
+	CocktailShakerSortFunc(data, cmp.Less[T])
+}
+
+// CocktailShakerSortFunc sorts a slice in place with cocktail shaker sort using the order defined by less.
+//
+// 1. Passes alternate direction: a forward pass moves the greatest unsorted element to the end,
+// a backward pass moves the smallest one to the front, so small elements near the end ("turtles")
+// no longer need one pass per position;
+//
+// 2. Both bounds shrink to the position of the last swap of the pass in that direction;
+//
+// 3. Runs in O(n^2) worst-case time and O(1) extra space. The sort is stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func CocktailShakerSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).cocktailShakerSort()
+}
+
+// cocktailShakerSort sorts the whole slice with cocktail shaker sort.
+func (s *sorter[T]) cocktailShakerSort() {
+	lo, hi := 1, len(s.data)
+
+	for lo < hi {
+		lastSwap := lo - 1
+		for i := lo; i < hi; i++ {
+			if s.lessAt(i, i-1) {
+				s.swap(i, i-1)
+				lastSwap = i
+			}
+		}
+
+		hi = lastSwap
+		if lo >= hi {
+			return
+		}
+
+		lastSwap = hi
+		for i := hi - 1; i >= lo; i-- {
+			if s.lessAt(i, i-1) {
+				s.swap(i, i-1)
+				lastSwap = i
+			}
+		}
+
+		lo = lastSwap + 1
+	}
+}
+
+// CombSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func CombSort[T cmp.Ordered](data []T) {
+	CombSortFunc(data, cmp.Less[T])
+}
+
+// CombSortFunc sorts a slice in place with comb sort using the order defined by less.
+//
+// 1. Like bubble sort, but compares elements a gap apart; the gap starts at the length of the slice
+// and shrinks by a factor of 1.3 after every pass, which moves far-away elements quickly;
+//
+// 2. Gaps 9 and 10 are replaced by 11 ("combsort11"), which avoids slow gap sequences;
+//
+// 3. Once the gap reaches 1, passes continue until one makes no swaps;
+//
+// 4. Runs in O(n^2) worst-case time, close to O(n log n) on average, and O(1) extra space.
+// The sort is not stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func CombSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).combSort()
+}
+
+// combSort sorts the whole slice with comb sort.
+func (s *sorter[T]) combSort() {
+	gap := len(s.data)
+
+	for swapped := true; gap > 1 || swapped; {
+		gap = gap * 10 / combShrinkFactor
+		if gap == 9 || gap == 10 {
+			gap = 11
+		}
+		gap = max(gap, 1)
+
+		swapped = false
+		for i := gap; i < len(s.data); i++ {
+			if s.lessAt(i, i-gap) {
+				s.swap(i, i-gap)
+				swapped = true
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package sort
+
+import (
+	"cmp"
+	"math"
+)
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// BucketSort sorts a slice of floating-point values in ascending order with a bucket sort.
+//
+// NaN values are ordered before all other values, as with cmp.Less.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func BucketSort[F Float](data []F) {
+	bucketSort(data, func(v F) F { return v })
+}
+
+// BucketSortBy sorts a slice of records in ascending order of a floating-point key with a stable bucket sort.
+//
+// 1. The range between the smallest and the greatest key is split into n equal buckets, and records
+// are distributed into them in their original order; records with a NaN key form a bucket that goes first;
+//
+// 2. Every bucket is sorted with insertion sort, or with merge sort if it is long, so skewed inputs
+// stay O(n log n);
+//
+// 3. If the keys contain infinities, or their range overflows, the records are merge sorted directly;
+//
+// 4. Runs in O(n) expected time for uniformly distributed keys, O(n log n) in the worst case,
+// and O(n) extra space.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - key: a function that extracts the sort key of a record.
+func BucketSortBy[E any, F Float](data []E, key func(E) F) {
+	bucketSort(data, key)
+}
+
+// bucketSort implements BucketSort and BucketSortBy.
+func bucketSort[E any, F Float](data []E, key func(E) F) {
+	n := len(data)
+	if n < 2 {
+		return
+	}
+
+	s := newSorter(data, func(a, b E) bool { return cmp.Less(key(a), key(b)) })
+	scratch := make([]E, n)
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, record := range data {
+		if k := float64(key(record)); !math.IsNaN(k) {
+			lo = math.Min(lo, k)
+			hi = math.Max(hi, k)
+		}
+	}
+
+	span := hi - lo
+	if math.IsInf(span, 0) || math.IsNaN(span) {
+		s.mergeSortTopDown(scratch, 0, n)
+		return
+	}
+
+	// Bucket 0 holds the NaN keys, buckets 1..n the values from lo to hi.
+	bucketOf := func(record E) int {
+		k := float64(key(record))
+		switch {
+		case math.IsNaN(k):
+			return 0
+		case span == 0:
+			return 1
+		default:
+			return 1 + int((k-lo)/span*float64(n-1))
+		}
+	}
+
+	ends := make([]int, n+2)
+	for _, record := range data {
+		ends[bucketOf(record)+1]++
+	}
+
+	for i := 1; i < len(ends); i++ {
+		ends[i] += ends[i-1]
+	}
+
+	for _, record := range data {
+		bucket := bucketOf(record)
+		scratch[ends[bucket]] = record
+		ends[bucket]++
+	}
+
+	copy(data, scratch)
+
+	// After the distribution ends[b] is the end of bucket b.
+	for bucket := 1; bucket <= n; bucket++ {
+		start, end := ends[bucket-1], ends[bucket]
+
+		switch {
+		case end-start <= 1:
+		case end-start <= insertionSortCutoff:
+			s.insertionSort(start, end)
+		default:
+			s.mergeSortTopDown(scratch, start, end)
+		}
+	}
+}
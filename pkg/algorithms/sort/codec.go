@@ -0,0 +1,67 @@
+package sort
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+// Codec reads and writes the records of an external sort.
+type Codec[T any] interface {
+	// Decode reads the next record. It returns io.EOF when the input ends cleanly between records.
+	Decode(r *bufio.Reader) (T, error)
+	// Encode writes a record so that Decode can read it back.
+	Encode(w *bufio.Writer, record T) error
+}
+
+// LinesCodec is a Codec for text records separated by "\n".
+//
+// A "\r" before the separator is dropped, and the last line of the input does not need a separator.
+type LinesCodec struct{}
+
+// Decode reads the next line without its line ending.
+func (LinesCodec) Decode(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+
+	return strings.TrimSuffix(line, "\r"), nil
+}
+
+// Encode writes the line followed by "\n".
+func (LinesCodec) Encode(w *bufio.Writer, line string) error {
+	if _, err := w.WriteString(line); err != nil {
+		return err
+	}
+
+	return w.WriteByte('\n')
+}
+
+// Int64Codec is a Codec for 64-bit integers stored as 8 big-endian bytes.
+type Int64Codec struct{}
+
+// Decode reads the next integer; a truncated record is reported as io.ErrUnexpectedEOF.
+func (Int64Codec) Decode(r *bufio.Reader) (int64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(buf[:])), nil
+}
+
+// Encode writes the integer as 8 big-endian bytes.
+func (Int64Codec) Encode(w *bufio.Writer, value int64) error {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(value))
+	_, err := w.Write(buf[:])
+
+	return err
+}
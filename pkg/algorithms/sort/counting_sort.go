@@ -0,0 +1,130 @@
+package sort
+
+import "fmt"
+
+// MaxCountingRange is the largest number of distinct key values, max - min + 1,
+// that the counting sorts allocate counters for.
+const MaxCountingRange = 1 << 24
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// CountingSort sorts a slice of integers in ascending order by counting the occurrences of each value.
+//
+// 1. Find the smallest and the greatest value, which bound the counter table;
+//
+// 2. Count every value and write each one back as many times as it occurred;
+//
+// 3. Runs in O(n + k) time and O(k) extra space, where k = max - min + 1.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+//
+// Returns ErrKeyRangeTooLarge if k exceeds MaxCountingRange; data is left unchanged in that case.
+func CountingSort[T Integer](data []T) error {
+	if len(data) < 2 {
+		return nil
+	}
+
+	lo, hi := integerBounds(data, func(v T) T { return v })
+
+	counts, err := countingTable(lo, hi)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range data {
+		counts[offsetOf(v, lo)]++
+	}
+
+	i := 0
+	for offset, count := range counts {
+		value := lo + T(offset)
+		for ; count > 0; count-- {
+			data[i] = value
+			i++
+		}
+	}
+
+	return nil
+}
+
+// CountingSortBy sorts a slice of records in ascending order of an integer key with a stable counting sort.
+//
+// 1. Count the occurrences of each key and turn the counts into the starting position of every key;
+//
+// 2. Move the records to a buffer in their original order, so records with equal keys stay in order;
+//
+// 3. Runs in O(n + k) time and O(n + k) extra space, where k = max - min + 1.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - key: a function that extracts the sort key of a record; it is called twice per record.
+//
+// Returns ErrKeyRangeTooLarge if k exceeds MaxCountingRange; data is left unchanged in that case.
+func CountingSortBy[E any, K Integer](data []E, key func(E) K) error {
+	if len(data) < 2 {
+		return nil
+	}
+
+	lo, hi := integerBounds(data, key)
+
+	counts, err := countingTable(lo, hi)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]uint64, len(data))
+	for i, record := range data {
+		keys[i] = offsetOf(key(record), lo)
+		counts[keys[i]]++
+	}
+
+	position := 0
+	for offset, count := range counts {
+		counts[offset] = position
+		position += count
+	}
+
+	sorted := make([]E, len(data))
+	for i, record := range data {
+		sorted[counts[keys[i]]] = record
+		counts[keys[i]]++
+	}
+
+	copy(data, sorted)
+
+	return nil
+}
+
+// integerBounds returns the smallest and the greatest key of a non-empty slice.
+func integerBounds[E any, K Integer](data []E, key func(E) K) (K, K) {
+	lo := key(data[0])
+	hi := lo
+
+	for _, record := range data[1:] {
+		k := key(record)
+		lo = min(lo, k)
+		hi = max(hi, k)
+	}
+
+	return lo, hi
+}
+
+// countingTable allocates one counter per value in [lo, hi].
+func countingTable[K Integer](lo, hi K) ([]int, error) {
+	span := offsetOf(hi, lo)
+	if span >= MaxCountingRange {
+		return nil, fmt.Errorf("%w: keys span [%d, %d], limit is %d values", ErrKeyRangeTooLarge, lo, hi, MaxCountingRange)
+	}
+
+	return make([]int, span+1), nil
+}
+
+// offsetOf returns v - lo for v >= lo without overflowing, even when the difference does not fit in K.
+func offsetOf[K Integer](v, lo K) uint64 {
+	return uint64(v) - uint64(lo)
+}
@@ -0,0 +1,19 @@
+package sort
+
+import "errors"
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown sort algorithm")
+
+	ErrKeyRangeTooLarge = errors.New("key range is too large for counting sort")
+
+	ErrIncompleteSorter = errors.New("external sorter needs a codec and a less function")
+
+	ErrIndexOutOfRange = errors.New("index is out of range")
+
+	ErrEmptyInput = errors.New("input is empty")
+
+	ErrInvalidPercentile = errors.New("percentile must be within [0, 100]")
+
+	ErrNetworkSize = errors.New("invalid sorting network size")
+)
@@ -0,0 +1,408 @@
+package sort
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/heap"
+)
+
+const (
+	// DefaultMemoryBudget is the number of bytes of encoded records an ExternalSorter keeps in memory by default.
+	DefaultMemoryBudget = 64 << 20
+	// DefaultFanIn is the number of runs an ExternalSorter merges at once by default.
+	DefaultFanIn = 128
+)
+
+// ExternalSorter sorts inputs that do not fit in memory.
+//
+// 1. The input is decoded into chunks that fit the memory budget; each chunk is sorted with the
+// package's stable MergeSorter and spilled to a temporary file as a sorted run;
+//
+// 2. The runs are merged with a k-way merge: a heap.Heap holds the smallest unread record of each run;
+//
+// 3. If there are more runs than FanIn, groups of FanIn runs are first merged into longer runs,
+// so the number of open files stays bounded;
+//
+// 4. An input that fits in a single chunk is sorted in memory without touching the disk.
+//
+// Records with equal keys keep their input order. The memory budget is measured by the encoded size
+// of the records, which approximates their size in memory for compact record types.
+//
+// Fields:
+//   - Codec: reads and writes the records;
+//   - Less: a function that returns true if a must be ordered before b;
+//   - MemoryBudget: the number of bytes of encoded records held in memory at once,
+//     DefaultMemoryBudget when not positive;
+//   - TempDir: the directory for the temporary runs, os.TempDir when empty;
+//   - Workers: the number of chunks sorted and spilled concurrently, one when not positive;
+//     the memory budget is shared between them;
+//   - FanIn: the maximum number of runs merged at once, DefaultFanIn when less than two.
+type ExternalSorter[T any] struct {
+	Codec        Codec[T]
+	Less         func(a, b T) bool
+	MemoryBudget int64
+	TempDir      string
+	Workers      int
+	FanIn        int
+}
+
+// NewExternalSorter creates a new ExternalSorter with the default budget, temp directory and a single worker.
+//
+// Parameters:
+//   - codec: reads and writes the records;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns a pointer to the new ExternalSorter.
+func NewExternalSorter[T any](codec Codec[T], less func(a, b T) bool) *ExternalSorter[T] {
+	return &ExternalSorter[T]{Codec: codec, Less: less}
+}
+
+// mergeItem is the heap entry of a k-way merge: the smallest unread record of a run.
+type mergeItem[T any] struct {
+	record T
+	run    int
+}
+
+// sameRun reports whether two merge items come from the same run. A heap holds one item per run, so
+// it is the equality of the heap: distinct items are never equal and the heap is ordered by less alone.
+func sameRun[T any](a, b mergeItem[T]) bool {
+	return a.run == b.run
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+// Read reads from the underlying reader and counts the bytes.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// Sort reads all records from r and writes them to w in sorted order.
+//
+// Temporary runs are created in a private directory inside TempDir, which is removed before
+// Sort returns, also on failure.
+//
+// Parameters:
+//   - r: the input records, encoded with Codec;
+//   - w: the destination of the sorted records, encoded with Codec.
+//
+// Returns ErrIncompleteSorter if Codec or Less is missing, or the first read, write or decode error.
+func (e *ExternalSorter[T]) Sort(r io.Reader, w io.Writer) (err error) {
+	if e.Codec == nil || e.Less == nil {
+		return ErrIncompleteSorter
+	}
+
+	workers := max(e.Workers, 1)
+	budget := e.MemoryBudget
+	if budget <= 0 {
+		budget = DefaultMemoryBudget
+	}
+
+	input := &countingReader{r: r}
+	reader := bufio.NewReader(input)
+	chunkBudget := max(budget/int64(workers), 1)
+
+	sorter := NewMergeSorter(e.Less, MergeTopDown)
+	chunk, eof, err := e.readChunk(reader, input, chunkBudget)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(w)
+
+	if eof {
+		sorter.Sort(chunk)
+		if err := e.writeRecords(writer, chunk); err != nil {
+			return err
+		}
+
+		return writer.Flush()
+	}
+
+	dir, err := os.MkdirTemp(e.TempDir, "extsort-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer func() {
+		if removeErr := os.RemoveAll(dir); err == nil && removeErr != nil {
+			err = fmt.Errorf("remove temp dir: %w", removeErr)
+		}
+	}()
+
+	runs, err := e.spillRuns(dir, chunk, reader, input, chunkBudget, workers)
+	if err != nil {
+		return err
+	}
+
+	fanIn := e.FanIn
+	if fanIn < 2 {
+		fanIn = DefaultFanIn
+	}
+
+	// Each pass merges consecutive groups, so earlier runs stay ahead of later ones and ties keep input order.
+	for len(runs) > fanIn {
+		next := make([]string, 0, (len(runs)+fanIn-1)/fanIn)
+
+		for lo := 0; lo < len(runs); lo += fanIn {
+			merged, err := e.mergeToRun(dir, runs[lo:min(lo+fanIn, len(runs))])
+			if err != nil {
+				return err
+			}
+
+			next = append(next, merged)
+		}
+
+		runs = next
+	}
+
+	if err := e.mergeRuns(runs, writer); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// readChunk decodes records until their encoded size reaches budget or the input ends.
+//
+// Returns the records and whether the input is exhausted.
+func (e *ExternalSorter[T]) readChunk(reader *bufio.Reader, input *countingReader, budget int64) ([]T, bool, error) {
+	var chunk []T
+	start := input.n - int64(reader.Buffered())
+
+	for input.n-int64(reader.Buffered())-start < budget {
+		record, err := e.Codec.Decode(reader)
+		if errors.Is(err, io.EOF) {
+			return chunk, true, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("decode record: %w", err)
+		}
+
+		chunk = append(chunk, record)
+	}
+
+	// Peek so that an input ending exactly at the budget is still sorted in memory.
+	if _, err := reader.Peek(1); errors.Is(err, io.EOF) {
+		return chunk, true, nil
+	}
+
+	return chunk, false, nil
+}
+
+// spillRuns sorts the first chunk and every following chunk of the input and writes each one to a
+// run file in dir, with up to workers chunks in flight.
+//
+// Returns the paths of the runs in input order.
+func (e *ExternalSorter[T]) spillRuns(
+	dir string, chunk []T, reader *bufio.Reader, input *countingReader, budget int64, workers int,
+) ([]string, error) {
+	// Each worker slot carries a MergeSorter so that scratch buffers are reused between chunks.
+	slots := make(chan *MergeSorter[T], workers)
+	for i := 0; i < workers; i++ {
+		slots <- NewMergeSorter(e.Less, MergeTopDown)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		runs     []string
+	)
+
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return firstErr != nil
+	}
+
+	// A slot is held while a chunk is read and until it is spilled, so at most workers chunks
+	// are in memory at once.
+	sorter := <-slots
+
+	for eof := false; ; {
+		file, err := os.CreateTemp(dir, "run-*")
+		if err != nil {
+			fail(fmt.Errorf("create run: %w", err))
+			slots <- sorter
+
+			break
+		}
+
+		runs = append(runs, file.Name())
+
+		wg.Add(1)
+		go func(sorter *MergeSorter[T], chunk []T) {
+			defer wg.Done()
+			defer func() { slots <- sorter }()
+
+			sorter.Sort(chunk)
+			if err := e.writeRun(file, chunk); err != nil {
+				fail(err)
+			}
+		}(sorter, chunk)
+
+		if eof || failed() {
+			break
+		}
+
+		sorter = <-slots
+
+		chunk, eof, err = e.readChunk(reader, input, budget)
+		if err != nil || len(chunk) == 0 {
+			if err != nil {
+				fail(err)
+			}
+
+			slots <- sorter
+
+			break
+		}
+	}
+
+	wg.Wait()
+
+	return runs, firstErr
+}
+
+// writeRun encodes records into file and closes it.
+func (e *ExternalSorter[T]) writeRun(file *os.File, records []T) error {
+	writer := bufio.NewWriter(file)
+
+	err := e.writeRecords(writer, records)
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("write run %s: %w", file.Name(), err)
+	}
+
+	return nil
+}
+
+// writeRecords encodes records to writer.
+func (e *ExternalSorter[T]) writeRecords(writer *bufio.Writer, records []T) error {
+	for _, record := range records {
+		if err := e.Codec.Encode(writer, record); err != nil {
+			return fmt.Errorf("encode record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mergeToRun merges runs into a new run file in dir and removes the merged runs.
+//
+// Returns the path of the new run.
+func (e *ExternalSorter[T]) mergeToRun(dir string, runs []string) (string, error) {
+	file, err := os.CreateTemp(dir, "run-*")
+	if err != nil {
+		return "", fmt.Errorf("create run: %w", err)
+	}
+
+	writer := bufio.NewWriter(file)
+
+	err = e.mergeRuns(runs, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, run := range runs {
+		if err := os.Remove(run); err != nil {
+			return "", fmt.Errorf("remove run: %w", err)
+		}
+	}
+
+	return file.Name(), nil
+}
+
+// mergeRuns merges sorted run files into writer with a k-way merge over heap.Heap.
+//
+// Ties between runs are broken by run order, which keeps the sort stable.
+func (e *ExternalSorter[T]) mergeRuns(runs []string, writer *bufio.Writer) error {
+	readers := make([]*bufio.Reader, len(runs))
+
+	for i, run := range runs {
+		file, err := os.Open(run)
+		if err != nil {
+			return fmt.Errorf("open run: %w", err)
+		}
+		defer file.Close()
+
+		readers[i] = bufio.NewReader(file)
+	}
+
+	items := make([]mergeItem[T], 0, len(runs))
+	for i, reader := range readers {
+		record, err := e.Codec.Decode(reader)
+		if errors.Is(err, io.EOF) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("decode run %s: %w", runs[i], err)
+		}
+
+		items = append(items, mergeItem[T]{record: record, run: i})
+	}
+
+	h := heap.NewHeap(items, func(a, b mergeItem[T]) bool {
+		if e.Less(a.record, b.record) {
+			return true
+		}
+
+		return !e.Less(b.record, a.record) && a.run < b.run
+	}, sameRun[T])
+
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+
+		if err := e.Codec.Encode(writer, item.record); err != nil {
+			return fmt.Errorf("encode record: %w", err)
+		}
+
+		record, err := e.Codec.Decode(readers[item.run])
+		if errors.Is(err, io.EOF) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("decode run %s: %w", runs[item.run], err)
+		}
+
+		h.Push(mergeItem[T]{record: record, run: item.run})
+	}
+
+	return nil
+}
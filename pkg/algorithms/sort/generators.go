@@ -0,0 +1,145 @@
+package sort
+
+import "math/rand"
+
+// Ascending returns the values 0..n-1 in ascending order.
+func Ascending(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i
+	}
+
+	return data
+}
+
+// Descending returns the values n-1..0 in descending order.
+func Descending(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = n - 1 - i
+	}
+
+	return data
+}
+
+// OrganPipe returns n values that ascend to the middle and descend after it, e.g. 0 1 2 2 1 0.
+func OrganPipe(n int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = min(i, n-1-i)
+	}
+
+	return data
+}
+
+// Sawtooth returns n values made of ascending runs 0..period-1.
+func Sawtooth(n, period int) []int {
+	data := make([]int, n)
+	for i := range data {
+		data[i] = i % max(period, 1)
+	}
+
+	return data
+}
+
+// FewUnique returns n pseudo-random values in [0, distinct), generated from seed.
+func FewUnique(n, distinct int, seed int64) []int {
+	rng := rand.New(rand.NewSource(seed))
+
+	data := make([]int, n)
+	for i := range data {
+		data[i] = rng.Intn(max(distinct, 1))
+	}
+
+	return data
+}
+
+// NearlySorted returns the values 0..n-1 in ascending order with swaps random pairs exchanged,
+// generated from seed.
+func NearlySorted(n, swaps int, seed int64) []int {
+	rng := rand.New(rand.NewSource(seed))
+
+	data := Ascending(n)
+	for i := 0; i < swaps && n > 1; i++ {
+		a, b := rng.Intn(n), rng.Intn(n)
+		data[a], data[b] = data[b], data[a]
+	}
+
+	return data
+}
+
+// MedianOfThreeKiller returns Musser's sequence of length n that drives a quicksort choosing the
+// median of the first, middle and last elements into quadratic time. For odd n the last value is n.
+func MedianOfThreeKiller(n int) []int {
+	k := n / 2
+	data := make([]int, n)
+
+	for i := 1; i <= k; i++ {
+		if i%2 == 1 {
+			data[i-1] = i
+		} else {
+			data[i-1] = k + i - 1
+		}
+
+		data[k+i-1] = 2 * i
+	}
+
+	if n%2 == 1 {
+		data[n-1] = n
+	}
+
+	return data
+}
+
+// KillerAdversary builds an input of length n on which sortFunc does as many comparisons as
+// McIlroy's adversary can force, which is quadratic for every quicksort that inspects O(1)
+// elements to pick its pivot.
+//
+// 1. Sort the items 0..n-1 with a comparison that decides the values lazily: every item starts as
+// "gas", a value greater than all decided values;
+//
+// 2. When two gas items are compared, one of them is frozen to the next smallest value. The adversary
+// freezes the item that was last compared with a decided item, which is probably the pivot, so the
+// pivot ends up as small as possible and the partition is maximally unbalanced;
+//
+// 3. The decided values form an input that makes sortFunc perform exactly the same comparisons again,
+// provided that sortFunc is deterministic and the items left as gas are all equal.
+//
+// Parameters:
+//   - n: the length of the input;
+//   - sortFunc: the sort to attack, e.g. QuickSortFunc[int].
+//
+// Returns the adversarial input.
+func KillerAdversary(n int, sortFunc func(data []int, less func(a, b int) bool)) []int {
+	gas := n
+	values := make([]int, n)
+	for i := range values {
+		values[i] = gas
+	}
+
+	solid, candidate := 0, 0
+	freeze := func(item int) {
+		values[item] = solid
+		solid++
+	}
+
+	sortFunc(Ascending(n), func(a, b int) bool {
+		if values[a] == gas && values[b] == gas {
+			if a == candidate {
+				freeze(a)
+			} else {
+				freeze(b)
+			}
+		}
+
+		if values[a] == gas {
+			candidate = a
+		} else if values[b] == gas {
+			candidate = b
+		}
+
+		return values[a] < values[b]
+	})
+
+	return values
+}
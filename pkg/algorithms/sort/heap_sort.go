@@ -1 +1,115 @@
 package sort
+
+import (
+	"cmp"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/heap"
+)
+
+// HeapSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func HeapSort[T cmp.Ordered](data []T) {
+	HeapSortFunc(data, cmp.Less[T])
+}
+
+// HeapSortFunc sorts a slice in place with heapsort using the order defined by less.
+//
+// 1. Arrange the slice into a max-heap with heap.Build;
+//
+// 2. Swap the root (the greatest element) with the last element of the heap, shrink the heap by one
+// and restore it with heap.SiftDown;
+//
+// 3. Runs in O(n log n) worst-case time and O(1) extra space. The sort is not stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func HeapSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).heapSort(0, len(data))
+}
+
+// PartialSort rearranges a slice of ordered values so that data[:k] holds its k smallest elements
+// in ascending order. The order of the remaining elements is unspecified.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the number of smallest elements to sort; clamped to [0, len(data)].
+func PartialSort[T cmp.Ordered](data []T, k int) {
+	PartialSortFunc(data, k, cmp.Less[T])
+}
+
+// PartialSortFunc rearranges a slice so that data[:k] holds its k smallest elements, according to less,
+// in ascending order. The order of the remaining elements is unspecified.
+//
+// 1. Build a max-heap of the first k elements, so its root is the greatest of the k smallest seen so far;
+//
+// 2. Every later element smaller than the root replaces it and is sifted down;
+//
+// 3. Sort the heap in place;
+//
+// 4. Runs in O(n log k) time and O(1) extra space.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the number of smallest elements to sort; clamped to [0, len(data)];
+//   - less: a function that returns true if a must be ordered before b.
+func PartialSortFunc[T any](data []T, k int, less func(a, b T) bool) {
+	newSorter(data, less).partialSort(k)
+}
+
+// partialSort moves the k smallest elements of data to data[:k] in ascending order.
+func (s *sorter[T]) partialSort(k int) {
+	k = max(0, min(k, len(s.data)))
+	if k == 0 {
+		return
+	}
+
+	above, swap := s.maxHeap(0)
+
+	heap.Build(k, above, swap)
+
+	for i := k; i < len(s.data); i++ {
+		if s.lessAt(i, 0) {
+			s.swap(0, i)
+			heap.SiftDown(0, k, above, swap)
+		}
+	}
+
+	s.sortMaxHeap(0, k)
+}
+
+// heapSort sorts data[lo:hi] in place using a binary max-heap.
+//
+// It is also the O(n log n) worst-case fallback of the introspective sorts.
+func (s *sorter[T]) heapSort(lo, hi int) {
+	above, swap := s.maxHeap(lo)
+
+	heap.Build(hi-lo, above, swap)
+	s.sortMaxHeap(lo, hi)
+}
+
+// sortMaxHeap sorts data[lo:hi], which must already be a max-heap rooted at lo,
+// by repeatedly moving the root behind the shrinking heap.
+func (s *sorter[T]) sortMaxHeap(lo, hi int) {
+	above, swap := s.maxHeap(lo)
+
+	for end := hi - lo - 1; end > 0; end-- {
+		swap(0, end)
+		heap.SiftDown(0, end, above, swap)
+	}
+}
+
+// maxHeap returns the index callbacks that let the heap package treat data[lo:] as a max-heap:
+// an element must be above another one if it is greater.
+func (s *sorter[T]) maxHeap(lo int) (func(a, b int) bool, func(a, b int)) {
+	above := func(a, b int) bool {
+		return s.lessAt(lo+b, lo+a)
+	}
+	swap := func(a, b int) {
+		s.swap(lo+a, lo+b)
+	}
+
+	return above, swap
+}
@@ -0,0 +1,78 @@
+package sort
+
+import "cmp"
+
+// InsertionSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func InsertionSort[T cmp.Ordered](data []T) {
+	InsertionSortFunc(data, cmp.Less[T])
+}
+
+// InsertionSortFunc sorts a slice in place with insertion sort using the order defined by less.
+//
+// 1. Each element is moved left past the greater elements of the sorted prefix;
+//
+// 2. Runs in O(n + inversions) time, so it is O(n) on sorted input and O(n^2) in the worst case,
+// and O(1) extra space. The sort is stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func InsertionSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).insertionSort(0, len(data))
+}
+
+// BinaryInsertionSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func BinaryInsertionSort[T cmp.Ordered](data []T) {
+	BinaryInsertionSortFunc(data, cmp.Less[T])
+}
+
+// BinaryInsertionSortFunc sorts a slice in place with binary insertion sort using the order defined by less.
+//
+// 1. The position of each element in the sorted prefix is found by binary search, after the last
+// element that is not greater, which keeps the sort stable;
+//
+// 2. The greater elements are shifted right by one and the element is written into the gap;
+//
+// 3. Uses O(n log n) comparisons, which pays off when comparisons are expensive,
+// but still O(n^2) moves, and O(1) extra space.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func BinaryInsertionSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).binaryInsertionSort(0, len(data))
+}
+
+// binaryInsertionSort sorts data[lo:hi] by binary insertion.
+func (s *sorter[T]) binaryInsertionSort(lo, hi int) {
+	s.binaryInsertionSortFrom(lo, lo+1, hi)
+}
+
+// binaryInsertionSortFrom sorts data[lo:hi] by binary insertion, given that data[lo:start] is already sorted.
+func (s *sorter[T]) binaryInsertionSortFrom(lo, start, hi int) {
+	for i := max(start, lo+1); i < hi; i++ {
+		left, right := lo, i
+		for left < right {
+			mid := int(uint(left+right) >> 1)
+			if s.lessAt(i, mid) {
+				right = mid
+			} else {
+				left = mid + 1
+			}
+		}
+
+		if left == i {
+			continue
+		}
+
+		value := s.data[i]
+		s.shiftRight(left, i)
+		s.write(left, value)
+	}
+}
@@ -0,0 +1,371 @@
+package sort
+
+import (
+	"cmp"
+	"context"
+	"sync"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/queues"
+)
+
+// KWayStrategy selects the structure KWayMerger uses to find the smallest head among the sources.
+type KWayStrategy int
+
+const (
+	// KWayHeap keeps the head of every source in a queues.PriorityQueue; each output element costs
+	// a pop and a push, about 2*log2(k) comparisons.
+	KWayHeap KWayStrategy = iota
+	// KWayLoserTree keeps the heads in a tournament tree of losers; each output element replays a single
+	// leaf-to-root path, exactly ceil(log2(k)) comparisons.
+	KWayLoserTree
+)
+
+// KWayMerger merges several sorted sequences into one sorted sequence.
+//
+// The merge is stable: equal elements keep the order of their sources, and inside a source
+// their original order. Every source must already be sorted by Less.
+//
+// Fields:
+//   - Less: a function that returns true if a must be ordered before b;
+//   - Dedup: if true, only the first of a group of equal elements is emitted;
+//   - Strategy: the structure used to pick the next element.
+type KWayMerger[T any] struct {
+	Less     func(a, b T) bool
+	Dedup    bool
+	Strategy KWayStrategy
+}
+
+// NewKWayMerger creates a new KWayMerger that uses a priority queue and keeps duplicates.
+//
+// Parameters:
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns a pointer to the new KWayMerger.
+func NewKWayMerger[T any](less func(a, b T) bool) *KWayMerger[T] {
+	return &KWayMerger[T]{Less: less}
+}
+
+// KWayMerge merges sorted slices of ordered values into a new sorted slice.
+//
+// Parameters:
+//   - sources: the slices to merge, each sorted in ascending order.
+//
+// Returns the merged slice.
+func KWayMerge[T cmp.Ordered](sources ...[]T) []T {
+	return NewKWayMerger(cmp.Less[T]).Merge(sources...)
+}
+
+// KWayMergeFunc merges slices sorted by less into a new sorted slice.
+//
+// Parameters:
+//   - sources: the slices to merge, each sorted by less;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the merged slice.
+func KWayMergeFunc[T any](sources [][]T, less func(a, b T) bool) []T {
+	return NewKWayMerger(less).Merge(sources...)
+}
+
+// Merge merges sorted slices into a new sorted slice.
+//
+// Parameters:
+//   - sources: the slices to merge.
+//
+// Returns the merged slice.
+func (m *KWayMerger[T]) Merge(sources ...[]T) []T {
+	total := 0
+	for _, source := range sources {
+		total += len(source)
+	}
+
+	merged := make([]T, 0, total)
+	m.All(sources...)(func(value T) bool {
+		merged = append(merged, value)
+		return true
+	})
+
+	return merged
+}
+
+// All returns an iterator over the merge of sorted slices. Elements are produced on demand,
+// so the merged sequence is never held in memory.
+//
+// Parameters:
+//   - sources: the slices to merge.
+func (m *KWayMerger[T]) All(sources ...[]T) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		cursors := make([]func() (T, bool), len(sources))
+		for i, source := range sources {
+			cursors[i] = sliceCursor(source)
+		}
+
+		m.merge(cursors, yield)
+	}
+}
+
+// MergeIterators returns an iterator over the merge of sorted iterators, such as the All methods
+// of the linked lists.
+//
+// Each source is advanced on its own goroutine, one element ahead of the merge at most. The
+// goroutines finish when the returned iterator finishes or its consumer stops early.
+//
+// Parameters:
+//   - sources: the iterators to merge.
+func (m *KWayMerger[T]) MergeIterators(sources ...func(yield func(T) bool)) func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		cursors := make([]func() (T, bool), len(sources))
+		for i, source := range sources {
+			next, stop := pullIterator(source)
+			defer stop()
+
+			cursors[i] = next
+		}
+
+		m.merge(cursors, yield)
+	}
+}
+
+// MergeChannels merges sorted channels into a new channel, which is closed once every source
+// is closed.
+//
+// If ctx is cancelled, the merge stops and the output is closed early; callers that need to tell
+// a complete output from a truncated one check ctx.Err() after the output is drained.
+//
+// Parameters:
+//   - ctx: cancels the merge;
+//   - sources: the channels to merge.
+//
+// Returns the channel of merged elements.
+func (m *KWayMerger[T]) MergeChannels(ctx context.Context, sources ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	cursors := make([]func() (T, bool), len(sources))
+	for i, source := range sources {
+		cursors[i] = channelCursor(ctx, source)
+	}
+
+	go func() {
+		defer close(out)
+
+		m.merge(cursors, func(value T) bool {
+			select {
+			case out <- value:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return out
+}
+
+// merge merges the sequences produced by cursors into yield, stopping early if yield returns false.
+// A cursor returns false once its sequence is exhausted.
+func (m *KWayMerger[T]) merge(cursors []func() (T, bool), yield func(T) bool) {
+	emit := yield
+
+	if m.Dedup {
+		var last T
+		seen := false
+
+		emit = func(value T) bool {
+			if seen && !m.Less(last, value) {
+				return true
+			}
+
+			last, seen = value, true
+
+			return yield(value)
+		}
+	}
+
+	if m.Strategy == KWayLoserTree {
+		m.mergeLoserTree(cursors, emit)
+	} else {
+		m.mergeHeap(cursors, emit)
+	}
+}
+
+// mergeHeap merges cursors with a priority queue of their heads, ordered by value and then by source.
+func (m *KWayMerger[T]) mergeHeap(cursors []func() (T, bool), yield func(T) bool) {
+	heads := make([]mergeItem[T], 0, len(cursors))
+	for i, next := range cursors {
+		if value, ok := next(); ok {
+			heads = append(heads, mergeItem[T]{record: value, run: i})
+		}
+	}
+
+	pq := queues.NewPriorityQueue(heads, func(a, b mergeItem[T]) bool {
+		if m.Less(a.record, b.record) {
+			return true
+		}
+
+		return !m.Less(b.record, a.record) && a.run < b.run
+	}, sameRun[T])
+
+	for pq.Len() > 0 {
+		head, _ := pq.Pop()
+
+		if !yield(head.record) {
+			return
+		}
+
+		if value, ok := cursors[head.run](); ok {
+			pq.Push(mergeItem[T]{record: value, run: head.run})
+		}
+	}
+}
+
+// loserTree is a tournament tree over k sources.
+//
+// Leaf i is node k+i, the parent of node n is n/2. Every internal node stores the source that lost
+// the match played there, and node 0 stores the overall winner, i.e. the source with the smallest head.
+//
+// Fields:
+//   - less: a function that returns true if a must be ordered before b;
+//   - heads: the current head of every source;
+//   - live: whether a source still has a head; exhausted sources lose every match;
+//   - nodes: the losers of the internal nodes and the winner at index 0.
+type loserTree[T any] struct {
+	less  func(a, b T) bool
+	heads []T
+	live  []bool
+	nodes []int
+}
+
+// beats reports whether source a wins the match against source b: its head is smaller,
+// or equal and from an earlier source.
+func (t *loserTree[T]) beats(a, b int) bool {
+	if !t.live[a] || !t.live[b] {
+		return t.live[a]
+	}
+
+	if t.less(t.heads[a], t.heads[b]) {
+		return true
+	}
+
+	return !t.less(t.heads[b], t.heads[a]) && a < b
+}
+
+// build plays the matches of the subtree rooted at node and returns its winner.
+func (t *loserTree[T]) build(node int) int {
+	k := len(t.heads)
+	if node >= k {
+		return node - k
+	}
+
+	left, right := t.build(2*node), t.build(2*node+1)
+	if t.beats(left, right) {
+		t.nodes[node] = right
+		return left
+	}
+
+	t.nodes[node] = left
+
+	return right
+}
+
+// replay replays the matches on the path from the leaf of source winner to the root after its
+// head changed, and stores the new overall winner.
+func (t *loserTree[T]) replay(winner int) {
+	for node := (winner + len(t.heads)) / 2; node > 0; node /= 2 {
+		if t.beats(t.nodes[node], winner) {
+			t.nodes[node], winner = winner, t.nodes[node]
+		}
+	}
+
+	t.nodes[0] = winner
+}
+
+// mergeLoserTree merges cursors with a loser tree of their heads.
+func (m *KWayMerger[T]) mergeLoserTree(cursors []func() (T, bool), yield func(T) bool) {
+	k := len(cursors)
+	if k == 0 {
+		return
+	}
+
+	t := &loserTree[T]{
+		less:  m.Less,
+		heads: make([]T, k),
+		live:  make([]bool, k),
+		nodes: make([]int, k),
+	}
+
+	for i, next := range cursors {
+		t.heads[i], t.live[i] = next()
+	}
+
+	t.nodes[0] = t.build(1)
+
+	for winner := t.nodes[0]; t.live[winner]; winner = t.nodes[0] {
+		if !yield(t.heads[winner]) {
+			return
+		}
+
+		t.heads[winner], t.live[winner] = cursors[winner]()
+		t.replay(winner)
+	}
+}
+
+// sliceCursor returns a cursor over the elements of data.
+func sliceCursor[T any](data []T) func() (T, bool) {
+	i := 0
+
+	return func() (T, bool) {
+		if i == len(data) {
+			var zero T
+			return zero, false
+		}
+
+		i++
+
+		return data[i-1], true
+	}
+}
+
+// channelCursor returns a cursor that receives from source until it is closed or ctx is cancelled.
+func channelCursor[T any](ctx context.Context, source <-chan T) func() (T, bool) {
+	return func() (T, bool) {
+		select {
+		case value, ok := <-source:
+			return value, ok
+		case <-ctx.Done():
+			var zero T
+			return zero, false
+		}
+	}
+}
+
+// pullIterator turns a push iterator into a cursor driven by a goroutine.
+//
+// Returns the cursor and a function that stops the goroutine; stop may be called more than once.
+func pullIterator[T any](seq func(yield func(T) bool)) (func() (T, bool), func()) {
+	values := make(chan T)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(values)
+
+		seq(func(value T) bool {
+			select {
+			case values <- value:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	next := func() (T, bool) {
+		select {
+		case value, ok := <-values:
+			return value, ok
+		case <-done:
+			var zero T
+			return zero, false
+		}
+	}
+
+	return next, sync.OnceFunc(func() { close(done) })
+}
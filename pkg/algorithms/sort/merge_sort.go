@@ -1 +1,281 @@
 package sort
+
+import (
+	"cmp"
+	"runtime"
+	"sync"
+)
+
+// DefaultParallelThreshold is the range length below which the parallel merge sort stops spawning goroutines.
+const DefaultParallelThreshold = 1 << 13
+
+// MergeMode selects the strategy used by MergeSorter.
+type MergeMode int
+
+const (
+	// MergeTopDown recursively halves the slice and merges the sorted halves.
+	MergeTopDown MergeMode = iota
+	// MergeBottomUp detects the natural runs of the input and merges neighbouring runs until one is left.
+	MergeBottomUp
+	// MergeParallel works like MergeTopDown but sorts independent halves on separate goroutines.
+	MergeParallel
+)
+
+// MergeSorter is a stable merge sort that keeps its scratch buffer between calls,
+// so sorting many slices of similar size does not allocate after the first call.
+//
+// A MergeSorter must not be used by several goroutines at the same time.
+//
+// Fields:
+//   - Less: a function that returns true if a must be ordered before b;
+//   - Mode: the merge strategy;
+//   - Workers: the maximum number of goroutines used by MergeParallel, GOMAXPROCS when not positive;
+//   - ParallelThreshold: the range length below which MergeParallel sorts serially,
+//     DefaultParallelThreshold when not positive;
+//   - Tracer: the observer of the operations, nil to disable tracing; MergeParallel calls it from
+//     several goroutines, one call at a time, so its events arrive in no fixed order;
+//   - buffer: the scratch buffer reused between calls.
+type MergeSorter[T any] struct {
+	Less              func(a, b T) bool
+	Mode              MergeMode
+	Workers           int
+	ParallelThreshold int
+	Tracer            Tracer[T]
+	buffer            []T
+}
+
+// NewMergeSorter creates a new MergeSorter with the given order and mode.
+//
+// Parameters:
+//   - less: a function that returns true if a must be ordered before b;
+//   - mode: the merge strategy.
+//
+// Returns a pointer to the new MergeSorter.
+func NewMergeSorter[T any](less func(a, b T) bool, mode MergeMode) *MergeSorter[T] {
+	return &MergeSorter[T]{Less: less, Mode: mode}
+}
+
+// Sort sorts data in place, keeping equal elements in their original order.
+//
+// 1. Ranges shorter than the insertion-sort cutoff are sorted by insertion;
+//
+// 2. Two sorted neighbouring ranges that are already in order are not merged, so sorted input
+// is handled in O(n) time;
+//
+// 3. Runs in O(n log n) time and uses n elements of scratch space.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func (m *MergeSorter[T]) Sort(data []T) {
+	n := len(data)
+	if n < 2 {
+		return
+	}
+
+	if cap(m.buffer) < n {
+		m.buffer = make([]T, n)
+	}
+
+	buf := m.buffer[:n]
+	s := &sorter[T]{data: data, less: m.Less, tracer: m.Tracer}
+
+	switch {
+	case m.Mode == MergeBottomUp:
+		s.mergeSortBottomUp(buf)
+	case m.Mode == MergeParallel:
+		workers := m.Workers
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+
+		threshold := m.ParallelThreshold
+		if threshold <= 0 {
+			threshold = DefaultParallelThreshold
+		}
+
+		if s.tracer != nil {
+			s.tracer = &syncTracer[T]{tracer: s.tracer}
+		}
+
+		s.mergeSortParallel(buf, 0, n, threshold, make(chan struct{}, workers-1))
+	default:
+		s.mergeSortTopDown(buf, 0, n)
+	}
+
+	// Drop references held by the scratch buffer so that it does not keep sorted elements alive.
+	clear(buf)
+}
+
+// MergeSort sorts a slice of ordered values in ascending order with a stable top-down merge sort.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func MergeSort[T cmp.Ordered](data []T) {
+	MergeSortFunc(data, cmp.Less[T])
+}
+
+// MergeSortFunc sorts a slice in place with a stable top-down merge sort using the order defined by less.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func MergeSortFunc[T any](data []T, less func(a, b T) bool) {
+	NewMergeSorter(less, MergeTopDown).Sort(data)
+}
+
+// MergeSortParallel sorts a slice in place with a stable merge sort that uses up to workers goroutines.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b;
+//   - workers: the maximum number of goroutines, GOMAXPROCS when not positive.
+func MergeSortParallel[T any](data []T, less func(a, b T) bool, workers int) {
+	m := NewMergeSorter(less, MergeParallel)
+	m.Workers = workers
+	m.Sort(data)
+}
+
+// mergeSortTopDown sorts data[lo:hi] recursively.
+func (s *sorter[T]) mergeSortTopDown(buf []T, lo, hi int) {
+	if hi-lo <= insertionSortCutoff {
+		s.insertionSort(lo, hi)
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	s.mergeSortTopDown(buf, lo, mid)
+	s.mergeSortTopDown(buf, mid, hi)
+	s.merge(buf, lo, mid, hi)
+}
+
+// mergeSortParallel sorts data[lo:hi] recursively, running the left half on a new goroutine
+// while a worker slot is free and the range is longer than threshold.
+func (s *sorter[T]) mergeSortParallel(buf []T, lo, hi, threshold int, slots chan struct{}) {
+	if hi-lo < threshold {
+		s.mergeSortTopDown(buf, lo, hi)
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+
+	select {
+	case slots <- struct{}{}:
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			s.mergeSortParallel(buf, lo, mid, threshold, slots)
+			<-slots
+		}()
+
+		s.mergeSortParallel(buf, mid, hi, threshold, slots)
+		wg.Wait()
+	default:
+		s.mergeSortParallel(buf, lo, mid, threshold, slots)
+		s.mergeSortParallel(buf, mid, hi, threshold, slots)
+	}
+
+	s.merge(buf, lo, mid, hi)
+}
+
+// mergeSortBottomUp sorts data by merging natural runs.
+//
+// Non-descending runs are kept, strictly descending runs are reversed (strictness keeps the sort
+// stable) and runs shorter than the insertion-sort cutoff are extended by insertion. Neighbouring
+// runs are then merged pairwise until a single run is left.
+func (s *sorter[T]) mergeSortBottomUp(buf []T) {
+	n := len(s.data)
+	bounds := []int{0}
+
+	for lo := 0; lo < n; {
+		hi := s.countRun(lo, n)
+
+		if hi-lo < insertionSortCutoff {
+			end := min(lo+insertionSortCutoff, n)
+			s.insertionSort(lo, end)
+			hi = end
+		}
+
+		bounds = append(bounds, hi)
+		lo = hi
+	}
+
+	for len(bounds) > 2 {
+		merged := bounds[:1]
+
+		for i := 2; i < len(bounds); i += 2 {
+			s.merge(buf, bounds[i-2], bounds[i-1], bounds[i])
+			merged = append(merged, bounds[i])
+		}
+
+		if len(bounds)%2 == 0 {
+			merged = append(merged, bounds[len(bounds)-1])
+		}
+
+		bounds = merged
+	}
+}
+
+// countRun returns the end of the run starting at lo, reversing it first if it is strictly descending.
+func (s *sorter[T]) countRun(lo, hi int) int {
+	end := lo + 1
+	if end == hi {
+		return end
+	}
+
+	if s.lessAt(end, lo) {
+		for end++; end < hi && s.lessAt(end, end-1); end++ {
+		}
+
+		s.reverse(lo, end)
+
+		return end
+	}
+
+	for end++; end < hi && !s.lessAt(end, end-1); end++ {
+	}
+
+	return end
+}
+
+// reverse reverses data[lo:hi].
+func (s *sorter[T]) reverse(lo, hi int) {
+	for i, j := lo, hi-1; i < j; i, j = i+1, j-1 {
+		s.swap(i, j)
+	}
+}
+
+// merge merges the sorted ranges data[lo:mid] and data[mid:hi] using buf[lo:mid] as scratch space.
+//
+// Nothing is copied when the last element of the left range is not greater than the first of the
+// right range. On ties the element from the left range is taken first, which keeps the merge stable.
+func (s *sorter[T]) merge(buf []T, lo, mid, hi int) {
+	if lo == mid || mid == hi {
+		return
+	}
+
+	s.partitioned(lo, mid, mid, hi)
+
+	if !s.lessAt(mid, mid-1) {
+		return
+	}
+
+	left := buf[lo:mid]
+	copy(left, s.data[lo:mid])
+
+	i, j, k := 0, mid, lo
+	for i < len(left) && j < hi {
+		if s.lessValues(s.data[j], j, left[i], lo+i) {
+			s.write(k, s.data[j])
+			j++
+		} else {
+			s.write(k, left[i])
+			i++
+		}
+
+		k++
+	}
+
+	s.writeAll(k, left[i:])
+}
@@ -0,0 +1,354 @@
+package sort
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+const (
+	// pdqInsertionThreshold is the length below which pdqsort switches to insertion sort.
+	pdqInsertionThreshold = 24
+	// pdqNintherThreshold is the length from which pdqsort chooses its pivot with Tukey's ninther.
+	pdqNintherThreshold = 128
+	// pdqPartialInsertionLimit is the number of moves after which an attempt to finish a range
+	// with insertion sort is abandoned.
+	pdqPartialInsertionLimit = 8
+	// pdqBlockSize is the number of elements scanned per block by the block partitioning.
+	pdqBlockSize = 64
+)
+
+// PDQSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func PDQSort[T cmp.Ordered](data []T) {
+	PDQSortFunc(data, cmp.Less[T])
+}
+
+// PDQSortFunc sorts a slice in place with pattern-defeating quicksort using the order defined by less.
+//
+// 1. The pivot is the median of three, or Tukey's ninther for long ranges;
+//
+// 2. Partitioning uses blocks (BlockQuicksort): the positions of misplaced elements are first
+// collected for a block of 64 elements on each side without branching on the comparison result,
+// then swapped pairwise, which avoids branch mispredictions on random data;
+//
+// 3. Patterns are detected and exploited: a range that needed no swaps while partitioning is
+// probably sorted and is finished with an insertion sort that gives up after a few moves;
+// if the pivot equals the element preceding the range, the range holds many duplicates and the
+// equal elements are split off in linear time; a highly unbalanced partition shuffles a few
+// elements to break the pattern that caused it;
+//
+// 4. After log2(n) unbalanced partitions the range is sorted with heapsort, so the sort runs in
+// O(n log n) worst-case time, O(n) on sorted and reversed input and O(n k) for k distinct values.
+// It uses O(log n) extra space and is not stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func PDQSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).pdqSort()
+}
+
+// pdqSort sorts the whole slice with pdqsort.
+func (s *sorter[T]) pdqSort() {
+	n := len(s.data)
+	if n < 2 {
+		return
+	}
+
+	s.pdqLoop(0, n, bits.Len(uint(n)), true)
+}
+
+// pdqLoop sorts data[lo:hi], recursing into the left partition and looping over the right one.
+//
+// badAllowed is the number of unbalanced partitions left before switching to heapsort;
+// leftmost is false if data[lo-1] is a pivot that no element of the range is smaller than.
+func (s *sorter[T]) pdqLoop(lo, hi, badAllowed int, leftmost bool) {
+	for {
+		size := hi - lo
+		if size < pdqInsertionThreshold {
+			s.insertionSort(lo, hi)
+			return
+		}
+
+		// Move the pivot to data[lo].
+		mid := lo + size/2
+		if size > pdqNintherThreshold {
+			s.sort3(lo, mid, hi-1)
+			s.sort3(lo+1, mid-1, hi-2)
+			s.sort3(lo+2, mid+1, hi-3)
+			s.sort3(mid-1, mid, mid+1)
+			s.swap(lo, mid)
+		} else {
+			s.sort3(mid, lo, hi-1)
+		}
+
+		// No element of the range is smaller than data[lo-1]; if the pivot is not greater either,
+		// the range starts with a run of elements equal to it, which needs no further sorting.
+		if !leftmost && !s.lessAt(lo-1, lo) {
+			lo = s.partitionEqual(lo, hi) + 1
+			continue
+		}
+
+		pivot, alreadyPartitioned := s.partitionBlocks(lo, hi)
+		s.partitioned(lo, pivot, pivot+1, hi)
+
+		leftSize, rightSize := pivot-lo, hi-pivot-1
+		if leftSize < size/8 || rightSize < size/8 {
+			badAllowed--
+			if badAllowed == 0 {
+				s.heapSort(lo, hi)
+				return
+			}
+
+			s.breakPatterns(lo, pivot, hi)
+		} else if alreadyPartitioned && s.partialInsertionSort(lo, pivot) && s.partialInsertionSort(pivot+1, hi) {
+			return
+		}
+
+		s.pdqLoop(lo, pivot, badAllowed, leftmost)
+		lo, leftmost = pivot+1, false
+	}
+}
+
+// sort3 sorts the elements at indices a, b and c.
+func (s *sorter[T]) sort3(a, b, c int) {
+	if s.lessAt(b, a) {
+		s.swap(a, b)
+	}
+	if s.lessAt(c, b) {
+		s.swap(b, c)
+		if s.lessAt(b, a) {
+			s.swap(a, b)
+		}
+	}
+}
+
+// partitionBlocks partitions data[lo:hi] around the pivot at data[lo] with block partitioning.
+//
+// Elements smaller than the pivot go to its left, the others to its right. The pivot itself stays
+// at data[lo] until the end, so it serves as the comparison reference.
+//
+// Returns the final position of the pivot and whether the range was already partitioned.
+func (s *sorter[T]) partitionBlocks(lo, hi int) (int, bool) {
+	first, last := lo+1, hi
+
+	// Find the first element not smaller than the pivot; the median selection guarantees one exists.
+	for s.lessAt(first, lo) {
+		first++
+	}
+
+	// Find the last element smaller than the pivot; guard the search if nothing was smaller.
+	if first-1 == lo {
+		for last--; first < last && !s.lessAt(last, lo); last-- {
+		}
+	} else {
+		for last--; !s.lessAt(last, lo); last-- {
+		}
+	}
+
+	alreadyPartitioned := first >= last
+	if !alreadyPartitioned {
+		s.swap(first, last)
+		first++
+	}
+
+	// [first, last) is unknown from here on. offsetsLeft[startLeft:startLeft+numLeft] are the offsets
+	// from first of elements that belong to the right, offsetsRight likewise for last - offset.
+	var offsetsLeft, offsetsRight [pdqBlockSize]int
+	numLeft, numRight, startLeft, startRight := 0, 0, 0, 0
+
+	for last-first > 2*pdqBlockSize {
+		if numLeft == 0 {
+			startLeft = 0
+			for i := 0; i < pdqBlockSize; i++ {
+				offsetsLeft[numLeft] = i
+				numLeft += boolToInt(!s.lessAt(first+i, lo))
+			}
+		}
+
+		if numRight == 0 {
+			startRight = 0
+			for i := 1; i <= pdqBlockSize; i++ {
+				offsetsRight[numRight] = i
+				numRight += boolToInt(s.lessAt(last-i, lo))
+			}
+		}
+
+		num := min(numLeft, numRight)
+		s.swapOffsets(first, last, offsetsLeft[startLeft:startLeft+num], offsetsRight[startRight:startRight+num])
+		numLeft, numRight = numLeft-num, numRight-num
+		startLeft, startRight = startLeft+num, startRight+num
+
+		if numLeft == 0 {
+			first += pdqBlockSize
+		}
+		if numRight == 0 {
+			last -= pdqBlockSize
+		}
+	}
+
+	// Fewer than two blocks are left: scan the remaining unknown elements with shorter blocks.
+	unknown := last - first
+	if numLeft != 0 || numRight != 0 {
+		unknown -= pdqBlockSize
+	}
+
+	leftSize, rightSize := unknown/2, unknown-unknown/2
+	switch {
+	case numRight != 0:
+		leftSize, rightSize = unknown, pdqBlockSize
+	case numLeft != 0:
+		leftSize, rightSize = pdqBlockSize, unknown
+	}
+
+	if unknown > 0 && numLeft == 0 {
+		startLeft = 0
+		for i := 0; i < leftSize; i++ {
+			offsetsLeft[numLeft] = i
+			numLeft += boolToInt(!s.lessAt(first+i, lo))
+		}
+	}
+
+	if unknown > 0 && numRight == 0 {
+		startRight = 0
+		for i := 1; i <= rightSize; i++ {
+			offsetsRight[numRight] = i
+			numRight += boolToInt(s.lessAt(last-i, lo))
+		}
+	}
+
+	num := min(numLeft, numRight)
+	s.swapOffsets(first, last, offsetsLeft[startLeft:startLeft+num], offsetsRight[startRight:startRight+num])
+	numLeft, numRight = numLeft-num, numRight-num
+	startLeft, startRight = startLeft+num, startRight+num
+
+	if numLeft == 0 {
+		first += leftSize
+	}
+	if numRight == 0 {
+		last -= rightSize
+	}
+
+	// One side still has misplaced elements: move them next to the boundary.
+	if numLeft != 0 {
+		for numLeft--; numLeft >= 0; numLeft-- {
+			last--
+			s.swap(first+offsetsLeft[startLeft+numLeft], last)
+		}
+
+		first = last
+	}
+
+	if numRight != 0 {
+		for numRight--; numRight >= 0; numRight-- {
+			s.swap(last-offsetsRight[startRight+numRight], first)
+			first++
+		}
+	}
+
+	pivot := first - 1
+	s.swap(lo, pivot)
+
+	return pivot, alreadyPartitioned
+}
+
+// swapOffsets swaps the misplaced elements data[first+left[i]] and data[last-right[i]] pairwise.
+func (s *sorter[T]) swapOffsets(first, last int, left, right []int) {
+	for i := range left {
+		s.swap(first+left[i], last-right[i])
+	}
+}
+
+// partitionEqual partitions data[lo:hi] around the pivot at data[lo], putting the elements equal
+// to it on its left. It is used when no element of the range is smaller than the pivot.
+//
+// Returns the final position of the pivot; data[lo:pivot+1] are all equal to it.
+func (s *sorter[T]) partitionEqual(lo, hi int) int {
+	first, last := lo, hi
+
+	for last--; s.lessAt(lo, last); last-- {
+	}
+
+	if last+1 == hi {
+		for first++; first < last && !s.lessAt(lo, first); first++ {
+		}
+	} else {
+		for first++; !s.lessAt(lo, first); first++ {
+		}
+	}
+
+	for first < last {
+		s.swap(first, last)
+
+		for last--; s.lessAt(lo, last); last-- {
+		}
+		for first++; !s.lessAt(lo, first); first++ {
+		}
+	}
+
+	s.swap(lo, last)
+
+	return last
+}
+
+// partialInsertionSort tries to sort data[lo:hi] with insertion sort, giving up after
+// pdqPartialInsertionLimit element moves.
+//
+// Returns true if the range was sorted.
+func (s *sorter[T]) partialInsertionSort(lo, hi int) bool {
+	moves := 0
+
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && s.lessAt(j, j-1); j-- {
+			s.swap(j, j-1)
+			moves++
+		}
+
+		if moves > pdqPartialInsertionLimit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// breakPatterns swaps a few elements of both partitions of data[lo:hi] around pivot
+// to defeat inputs that keep producing unbalanced partitions.
+func (s *sorter[T]) breakPatterns(lo, pivot, hi int) {
+	if leftSize := pivot - lo; leftSize >= pdqInsertionThreshold {
+		quarter := leftSize / 4
+		s.swap(lo, lo+quarter)
+		s.swap(pivot-1, pivot-quarter)
+
+		if leftSize > pdqNintherThreshold {
+			s.swap(lo+1, lo+quarter+1)
+			s.swap(lo+2, lo+quarter+2)
+			s.swap(pivot-2, pivot-quarter-1)
+			s.swap(pivot-3, pivot-quarter-2)
+		}
+	}
+
+	if rightSize := hi - pivot - 1; rightSize >= pdqInsertionThreshold {
+		quarter := rightSize / 4
+		s.swap(pivot+1, pivot+1+quarter)
+		s.swap(hi-1, hi-quarter)
+
+		if rightSize > pdqNintherThreshold {
+			s.swap(pivot+2, pivot+2+quarter)
+			s.swap(pivot+3, pivot+3+quarter)
+			s.swap(hi-2, hi-1-quarter)
+			s.swap(hi-3, hi-2-quarter)
+		}
+	}
+}
+
+// boolToInt converts a comparison result to 0 or 1 so it can be accumulated without a branch.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
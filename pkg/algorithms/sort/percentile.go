@@ -0,0 +1,100 @@
+package sort
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Number is a constraint for the integer and floating-point types percentiles can be computed for.
+type Number interface {
+	Integer | Float
+}
+
+// Median returns the median of a slice of numbers. For an even length it is the mean of the two
+// middle elements. The slice itself is not modified.
+//
+// Parameters:
+//   - data: the numbers.
+//
+// Returns the median and ErrEmptyInput if data is empty.
+func Median[T Number](data []T) (float64, error) {
+	return Percentile(data, 50)
+}
+
+// Percentile returns the p-th percentile of a slice of numbers. The slice itself is not modified.
+//
+// The percentile is linearly interpolated between the closest ranks, as in the default method of
+// NumPy and R (type 7): the element at rank r = p/100 * (len(data)-1) is looked up in sorted order,
+// and a fractional rank blends the elements at floor(r) and ceil(r).
+//
+// Parameters:
+//   - data: the numbers;
+//   - p: the percentile within [0, 100].
+//
+// NaN values are ordered first, as with cmp.Less, so they only affect the lowest percentiles.
+//
+// Returns the percentile, ErrEmptyInput if data is empty and ErrInvalidPercentile if p is out of range.
+func Percentile[T Number](data []T, p float64) (float64, error) {
+	values, err := Percentiles(data, p)
+	if err != nil {
+		return 0, err
+	}
+
+	return values[0], nil
+}
+
+// Percentiles returns several percentiles of a slice of numbers, computed like Percentile.
+// The slice itself is not modified.
+//
+// 1. Collect the ranks on both sides of every requested percentile;
+//
+// 2. Place all of them in a copy of data with a single multi-rank introselect, which partitions
+// around each pivot once and recurses only into the parts that still contain a requested rank;
+//
+// 3. Runs in O(n log m) time for m percentiles, and never slower than a full sort.
+//
+// Parameters:
+//   - data: the numbers;
+//   - ps: the percentiles, each within [0, 100], in any order.
+//
+// Returns the percentiles in the order of ps, ErrEmptyInput if data is empty and
+// ErrInvalidPercentile if any of ps is out of range.
+func Percentiles[T Number](data []T, ps ...float64) ([]float64, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	ranks := make([]int, 0, 2*len(ps))
+	for _, p := range ps {
+		if !(p >= 0 && p <= 100) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPercentile, p)
+		}
+
+		rank := p / 100 * float64(len(data)-1)
+		ranks = append(ranks, int(math.Floor(rank)), int(math.Ceil(rank)))
+	}
+
+	slices.Sort(ranks)
+	ranks = slices.Compact(ranks)
+
+	sorted := slices.Clone(data)
+	s := newSorter(sorted, cmp.Less[T])
+	s.selectRanks(0, len(sorted), ranks, depthLimit(len(sorted)))
+
+	values := make([]float64, len(ps))
+	for i, p := range ps {
+		rank := p / 100 * float64(len(data)-1)
+		lo, hi := math.Floor(rank), math.Ceil(rank)
+		a, b := float64(sorted[int(lo)]), float64(sorted[int(hi)])
+
+		if lo == hi || a == b {
+			values[i] = a
+		} else {
+			values[i] = a + (b-a)*(rank-lo)
+		}
+	}
+
+	return values, nil
+}
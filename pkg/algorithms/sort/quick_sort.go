@@ -1 +1,157 @@
 package sort
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// nintherThreshold is the length from which PivotAdaptive switches from median-of-three to ninther.
+const nintherThreshold = 128
+
+// PivotStrategy selects how QuickSort chooses its pivot.
+type PivotStrategy int
+
+const (
+	// PivotAdaptive uses median-of-three for short ranges and ninther for long ones.
+	PivotAdaptive PivotStrategy = iota
+	// PivotMedianOfThree uses the median of the first, middle and last elements.
+	PivotMedianOfThree
+	// PivotNinther uses Tukey's ninther: the median of three medians-of-three spread over the range.
+	PivotNinther
+)
+
+// QuickSort sorts a slice of ordered values in ascending order.
+//
+// NaN values are ordered before all other floating-point values, as with cmp.Less.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func QuickSort[T cmp.Ordered](data []T) {
+	QuickSortFunc(data, cmp.Less[T])
+}
+
+// QuickSortFunc sorts a slice in place using the order defined by less.
+//
+// The sort is an introsort:
+//
+// 1. Ranges are partitioned three ways (Dutch national flag), so runs of equal elements are
+// excluded from further recursion and inputs with many duplicates sort in near-linear time;
+//
+// 2. Ranges shorter than the insertion-sort cutoff are finished with insertion sort;
+//
+// 3. If the recursion depth exceeds 2*log2(n), the remaining range is sorted with heapsort,
+// so adversarial inputs cannot make the sort quadratic;
+//
+// 4. Runs in O(n log n) worst-case time and O(log n) extra space. The sort is not stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func QuickSortFunc[T any](data []T, less func(a, b T) bool) {
+	QuickSortPivot(data, less, PivotAdaptive)
+}
+
+// QuickSortPivot sorts a slice in place like QuickSortFunc using the given pivot strategy.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b;
+//   - pivot: the pivot selection strategy.
+func QuickSortPivot[T any](data []T, less func(a, b T) bool, pivot PivotStrategy) {
+	s := newSorter(data, less)
+	s.quickSort(0, len(data), depthLimit(len(data)), pivot)
+}
+
+// depthLimit returns the recursion depth after which introsort falls back to heapsort: 2*floor(log2(n)).
+func depthLimit(n int) int {
+	if n <= 1 {
+		return 0
+	}
+
+	return 2 * (bits.Len(uint(n)) - 1)
+}
+
+// quickSort sorts data[lo:hi], recursing into the smaller partition and looping over the larger one
+// so that the stack depth stays O(log n).
+func (s *sorter[T]) quickSort(lo, hi, depth int, pivot PivotStrategy) {
+	for hi-lo > insertionSortCutoff {
+		if depth == 0 {
+			s.heapSort(lo, hi)
+			return
+		}
+
+		depth--
+
+		lt, gt := s.partition3(lo, hi, s.choosePivot(lo, hi, pivot))
+		s.partitioned(lo, lt, gt, hi)
+
+		if lt-lo < hi-gt {
+			s.quickSort(lo, lt, depth, pivot)
+			lo = gt
+		} else {
+			s.quickSort(gt, hi, depth, pivot)
+			hi = lt
+		}
+	}
+
+	s.insertionSort(lo, hi)
+}
+
+// partition3 partitions data[lo:hi] around the element at index p using Dijkstra's Dutch national flag scheme.
+//
+// Returns lt and gt such that data[lo:lt] < pivot, data[lt:gt] == pivot and data[gt:hi] > pivot.
+// The element at lt is always equal to the pivot, so it serves as the comparison reference.
+func (s *sorter[T]) partition3(lo, hi, p int) (int, int) {
+	s.swap(lo, p)
+
+	lt, i, gt := lo, lo+1, hi
+	for i < gt {
+		switch {
+		case s.lessAt(i, lt):
+			s.swap(lt, i)
+			lt++
+			i++
+		case s.lessAt(lt, i):
+			gt--
+			s.swap(i, gt)
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
+
+// choosePivot returns the index of the pivot for data[lo:hi].
+func (s *sorter[T]) choosePivot(lo, hi int, pivot PivotStrategy) int {
+	n := hi - lo
+	mid := lo + n/2
+
+	if pivot == PivotNinther || (pivot == PivotAdaptive && n >= nintherThreshold) {
+		step := n / 8
+
+		return s.medianOfThree(
+			s.medianOfThree(lo, lo+step, lo+2*step),
+			s.medianOfThree(mid-step, mid, mid+step),
+			s.medianOfThree(hi-1-2*step, hi-1-step, hi-1),
+		)
+	}
+
+	return s.medianOfThree(lo, mid, hi-1)
+}
+
+// medianOfThree returns the index holding the median of the elements at indices a, b and c.
+func (s *sorter[T]) medianOfThree(a, b, c int) int {
+	if s.lessAt(b, a) {
+		a, b = b, a
+	}
+
+	if s.lessAt(c, b) {
+		b = c
+		if s.lessAt(b, a) {
+			b = a
+		}
+	}
+
+	return b
+}
@@ -0,0 +1,265 @@
+package sort
+
+// radixBuckets is the number of buckets of one radix pass: the sorts consume keys one byte at a time.
+const radixBuckets = 256
+
+// ByteString is a constraint that permits string and byte slice types.
+type ByteString interface {
+	~string | ~[]byte
+}
+
+// RadixSort sorts a slice of integers of any width in ascending order with an LSD radix sort.
+//
+// 1. Keys are processed one byte at a time, from the least significant byte up, and every pass
+// is a stable counting sort on that byte;
+//
+// 2. The sign bit of signed types is flipped, so negative values order before positive ones;
+//
+// 3. The histograms of all bytes are built in a single scan, and passes where every key has the
+// same byte are skipped, so small values in a wide type cost few passes;
+//
+// 4. Runs in O(w * n) time and O(n) extra space, where w is the width of the type in bytes.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func RadixSort[T Integer](data []T) {
+	n := len(data)
+	if n < 2 {
+		return
+	}
+
+	width, flip := integerLayout[T]()
+	counts := make([][radixBuckets]int, width)
+
+	for _, v := range data {
+		key := uint64(v) ^ flip
+		for pass := range counts {
+			counts[pass][byte(key>>(8*pass))]++
+		}
+	}
+
+	src, dst := data, make([]T, n)
+	for pass := range counts {
+		offsets := &counts[pass]
+		if offsets[byte((uint64(src[0])^flip)>>(8*pass))] == n {
+			continue
+		}
+
+		prefixSums(offsets)
+
+		for _, v := range src {
+			digit := byte((uint64(v) ^ flip) >> (8 * pass))
+			dst[offsets[digit]] = v
+			offsets[digit]++
+		}
+
+		src, dst = dst, src
+	}
+
+	if &src[0] != &data[0] {
+		copy(data, src)
+	}
+}
+
+// RadixSortBy sorts a slice of records in ascending order of an integer key with a stable LSD radix sort.
+//
+// The keys are extracted once into a parallel slice that is permuted together with the records.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - key: a function that extracts the sort key of a record.
+func RadixSortBy[E any, K Integer](data []E, key func(E) K) {
+	n := len(data)
+	if n < 2 {
+		return
+	}
+
+	width, flip := integerLayout[K]()
+	counts := make([][radixBuckets]int, width)
+	keys := make([]uint64, n)
+
+	for i, record := range data {
+		keys[i] = uint64(key(record)) ^ flip
+		for pass := range counts {
+			counts[pass][byte(keys[i]>>(8*pass))]++
+		}
+	}
+
+	src, dst := data, make([]E, n)
+	srcKeys, dstKeys := keys, make([]uint64, n)
+
+	for pass := range counts {
+		offsets := &counts[pass]
+		if offsets[byte(srcKeys[0]>>(8*pass))] == n {
+			continue
+		}
+
+		prefixSums(offsets)
+
+		for i, k := range srcKeys {
+			digit := byte(k >> (8 * pass))
+			dst[offsets[digit]] = src[i]
+			dstKeys[offsets[digit]] = k
+			offsets[digit]++
+		}
+
+		src, dst = dst, src
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+
+	if &src[0] != &data[0] {
+		copy(data, src)
+	}
+}
+
+// MSDRadixSort sorts a slice of strings or byte slices in lexicographic byte order with an MSD radix sort.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func MSDRadixSort[S ByteString](data []S) {
+	if len(data) < 2 {
+		return
+	}
+
+	m := &msdSorter[S, S]{keys: data, keysBuf: make([]S, len(data))}
+	m.sort(0, len(data), 0)
+}
+
+// MSDRadixSortBy sorts a slice of records in lexicographic byte order of a string key
+// with a stable MSD radix sort.
+//
+// 1. Records are distributed into 256 buckets by the byte of the key at the current depth,
+// with keys that end before that depth in a bucket of their own that goes first;
+//
+// 2. Every bucket is sorted recursively by the next byte, and short buckets are finished with
+// insertion sort;
+//
+// 3. Only the distinguishing prefixes of the keys are examined, so the sort runs in O(D + n)
+// byte operations, where D is the total length of those prefixes, plus O(n) extra space.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - key: a function that extracts the sort key of a record; it is called once per record.
+func MSDRadixSortBy[E any, S ByteString](data []E, key func(E) S) {
+	n := len(data)
+	if n < 2 {
+		return
+	}
+
+	keys := make([]S, n)
+	for i, record := range data {
+		keys[i] = key(record)
+	}
+
+	m := &msdSorter[E, S]{items: data, itemsBuf: make([]E, n), keys: keys, keysBuf: make([]S, n)}
+	m.sort(0, n, 0)
+}
+
+// msdSorter holds the slices of an MSD radix sort.
+//
+// Fields:
+//   - items, itemsBuf: the records and their scratch buffer, nil when the keys are sorted on their own;
+//   - keys, keysBuf: the sort keys and their scratch buffer.
+type msdSorter[E any, S ByteString] struct {
+	items    []E
+	itemsBuf []E
+	keys     []S
+	keysBuf  []S
+}
+
+// sort sorts keys[lo:hi], whose first depth bytes are all equal.
+func (m *msdSorter[E, S]) sort(lo, hi, depth int) {
+	if hi-lo <= insertionSortCutoff {
+		m.insertionSort(lo, hi, depth)
+		return
+	}
+
+	// counts[0] is for keys that end at depth, counts[c+1] for byte c; the extra slot turns
+	// the counts into bucket boundaries after the prefix sums.
+	var counts [radixBuckets + 2]int
+	for _, k := range m.keys[lo:hi] {
+		counts[byteAt(k, depth)+2]++
+	}
+
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+
+	for i := lo; i < hi; i++ {
+		bucket := byteAt(m.keys[i], depth) + 1
+		m.keysBuf[lo+counts[bucket]] = m.keys[i]
+		if m.items != nil {
+			m.itemsBuf[lo+counts[bucket]] = m.items[i]
+		}
+
+		counts[bucket]++
+	}
+
+	copy(m.keys[lo:hi], m.keysBuf[lo:hi])
+	if m.items != nil {
+		copy(m.items[lo:hi], m.itemsBuf[lo:hi])
+	}
+
+	// After the distribution counts[b] is the end of bucket b; bucket 0 holds finished keys.
+	for bucket := 1; bucket <= radixBuckets; bucket++ {
+		if start, end := lo+counts[bucket-1], lo+counts[bucket]; end-start > 1 {
+			m.sort(start, end, depth+1)
+		}
+	}
+}
+
+// insertionSort sorts keys[lo:hi] by insertion, comparing the keys from depth on.
+func (m *msdSorter[E, S]) insertionSort(lo, hi, depth int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && lessFrom(m.keys[j], m.keys[j-1], depth); j-- {
+			m.keys[j], m.keys[j-1] = m.keys[j-1], m.keys[j]
+			if m.items != nil {
+				m.items[j], m.items[j-1] = m.items[j-1], m.items[j]
+			}
+		}
+	}
+}
+
+// byteAt returns the byte of s at position depth, or -1 if s is shorter.
+func byteAt[S ByteString](s S, depth int) int {
+	if depth < len(s) {
+		return int(s[depth])
+	}
+
+	return -1
+}
+
+// lessFrom reports whether a orders before b, comparing the bytes from depth on.
+func lessFrom[S ByteString](a, b S, depth int) bool {
+	for i := depth; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return len(a) < len(b)
+}
+
+// integerLayout returns the width of K in bytes and the mask that flips its sign bit,
+// which is zero for unsigned types.
+func integerLayout[K Integer]() (int, uint64) {
+	width := 1
+	for one := K(1); width < 8 && one<<(8*width) != 0; width++ {
+	}
+
+	var zero K
+	if ^zero < 0 {
+		return width, 1 << (8*width - 1)
+	}
+
+	return width, 0
+}
+
+// prefixSums turns the byte counts of a pass into the starting position of every bucket.
+func prefixSums(counts *[radixBuckets]int) {
+	position := 0
+	for digit, count := range counts {
+		counts[digit] = position
+		position += count
+	}
+}
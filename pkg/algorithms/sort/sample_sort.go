@@ -0,0 +1,199 @@
+package sort
+
+import (
+	"cmp"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// sampleOversampling is the number of samples drawn per bucket when choosing the splitters.
+	sampleOversampling = 32
+	// sampleSeed seeds the sample selection, so the buckets and the work split are the same on every run.
+	sampleSeed = 0x5a3c
+)
+
+// SampleSort sorts a slice of ordered values in ascending order with a stable parallel sample sort
+// that uses up to GOMAXPROCS goroutines.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func SampleSort[T cmp.Ordered](data []T) {
+	SampleSortParallel(data, cmp.Less[T], 0)
+}
+
+// SampleSortFunc sorts a slice in place like SampleSort using the order defined by less.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func SampleSortFunc[T any](data []T, less func(a, b T) bool) {
+	SampleSortParallel(data, less, 0)
+}
+
+// SampleSortParallel sorts a slice in place with a stable sample sort that uses up to workers goroutines.
+//
+// 1. Draw workers*32 samples with a fixed seed, sort them and pick workers-1 evenly spaced splitters;
+//
+// 2. Split the slice into one contiguous block per worker; every worker classifies its elements
+// into the buckets between the splitters. An element equal to a splitter goes into an extra bucket
+// of its own, so heavily duplicated keys do not overload a single bucket and need no further sorting;
+//
+// 3. Prefix sums over (bucket, block) give every worker its own output positions, so the elements are
+// scattered into a scratch slice concurrently and still keep their relative order;
+//
+// 4. The workers sort the buckets with a stable merge sort and copy them back;
+//
+// 5. Slices shorter than DefaultParallelThreshold, or a single worker, are sorted serially with MergeSortFunc.
+//
+// The output does not depend on the number of workers or on scheduling: the sort is stable, so
+// equal elements always end up in their input order. It runs in O(n log n) time and uses n elements
+// and n bucket indices of scratch space.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b;
+//   - workers: the maximum number of goroutines, GOMAXPROCS when not positive.
+func SampleSortParallel[T any](data []T, less func(a, b T) bool, workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	n := len(data)
+	if workers == 1 || n < DefaultParallelThreshold {
+		MergeSortFunc(data, less)
+		return
+	}
+
+	splitters := sampleSplitters(data, less, workers)
+	buckets := 2*len(splitters) + 1
+	blockSize := (n + workers - 1) / workers
+
+	// counts[w*buckets+b] is the number of elements of block w in bucket b; after the prefix sums
+	// it is the position of the first of them in the scratch slice.
+	counts := make([]int, workers*buckets)
+	ids := make([]int32, n)
+
+	parallelFor(workers, workers, func(w int) {
+		lo, hi := min(w*blockSize, n), min((w+1)*blockSize, n)
+		row := counts[w*buckets : (w+1)*buckets]
+
+		for i := lo; i < hi; i++ {
+			b := bucketOf(data[i], splitters, less)
+			ids[i] = int32(b)
+			row[b]++
+		}
+	})
+
+	starts := make([]int, buckets+1)
+	offset := 0
+	for b := 0; b < buckets; b++ {
+		starts[b] = offset
+		for w := 0; w < workers; w++ {
+			count := counts[w*buckets+b]
+			counts[w*buckets+b] = offset
+			offset += count
+		}
+	}
+	starts[buckets] = n
+
+	buf := make([]T, n)
+
+	parallelFor(workers, workers, func(w int) {
+		lo, hi := min(w*blockSize, n), min((w+1)*blockSize, n)
+		row := counts[w*buckets : (w+1)*buckets]
+
+		for i := lo; i < hi; i++ {
+			b := ids[i]
+			buf[row[b]] = data[i]
+			row[b]++
+		}
+	})
+
+	var next atomic.Int64
+
+	parallelFor(workers, workers, func(int) {
+		// Every worker owns a MergeSorter so that its scratch buffer is reused across buckets.
+		sorter := NewMergeSorter(less, MergeTopDown)
+
+		for {
+			b := int(next.Add(1) - 1)
+			if b >= buckets {
+				return
+			}
+
+			lo, hi := starts[b], starts[b+1]
+			if b%2 == 0 {
+				sorter.Sort(buf[lo:hi])
+			}
+
+			copy(data[lo:hi], buf[lo:hi])
+		}
+	})
+}
+
+// sampleSplitters returns up to buckets-1 splitters in ascending order, chosen from a sorted sample of data.
+func sampleSplitters[T any](data []T, less func(a, b T) bool, buckets int) []T {
+	rng := rand.New(rand.NewSource(sampleSeed))
+
+	sample := make([]T, buckets*sampleOversampling)
+	for i := range sample {
+		sample[i] = data[rng.Intn(len(data))]
+	}
+
+	MergeSortFunc(sample, less)
+
+	splitters := make([]T, 0, buckets-1)
+	for i := 1; i < buckets; i++ {
+		splitter := sample[i*sampleOversampling]
+
+		// Equal splitters would only produce empty buckets.
+		if len(splitters) == 0 || less(splitters[len(splitters)-1], splitter) {
+			splitters = append(splitters, splitter)
+		}
+	}
+
+	return splitters
+}
+
+// bucketOf returns the bucket of value: 2*i for the values between splitters[i-1] and splitters[i],
+// and 2*i+1 for the values equal to splitters[i].
+func bucketOf[T any](value T, splitters []T, less func(a, b T) bool) int {
+	lo, hi := 0, len(splitters)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if less(splitters[mid], value) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	if lo < len(splitters) && !less(value, splitters[lo]) {
+		return 2*lo + 1
+	}
+
+	return 2 * lo
+}
+
+// parallelFor runs body(0), ..., body(n-1) on up to workers goroutines and waits for all of them.
+func parallelFor(n, workers int, body func(i int)) {
+	var wg sync.WaitGroup
+	var next atomic.Int64
+
+	for w := 0; w < min(n, workers); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := int(next.Add(1) - 1); i < n; i = int(next.Add(1) - 1) {
+				body(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
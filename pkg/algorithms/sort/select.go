@@ -0,0 +1,220 @@
+package sort
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// medianGroupSize is the group size of the median-of-medians pivot.
+const medianGroupSize = 5
+
+// Select returns the element that would be at index k if the slice were sorted in ascending order.
+// The slice itself is not modified.
+//
+// NaN values are ordered before all other floating-point values, as with cmp.Less.
+//
+// Parameters:
+//   - data: the slice to select from;
+//   - k: the zero-based rank of the element.
+//
+// Returns the k-th smallest element and ErrIndexOutOfRange if k is not within [0, len(data)).
+func Select[T cmp.Ordered](data []T, k int) (T, error) {
+	return SelectFunc(data, k, cmp.Less[T])
+}
+
+// SelectFunc returns the element that would be at index k if the slice were sorted by less.
+// The slice itself is not modified.
+//
+// Parameters:
+//   - data: the slice to select from;
+//   - k: the zero-based rank of the element;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns the k-th smallest element and ErrIndexOutOfRange if k is not within [0, len(data)).
+func SelectFunc[T any](data []T, k int, less func(a, b T) bool) (T, error) {
+	if err := checkRank(k, len(data)); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	data = slices.Clone(data)
+	newSorter(data, less).introSelect(0, len(data), k)
+
+	return data[k], nil
+}
+
+// NthElement rearranges a slice of ordered values so that data[k] holds the element that would be
+// there if the slice were sorted, no element of data[:k] is greater than it and no element of
+// data[k+1:] is smaller. The order inside both sides is unspecified.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the zero-based rank to place.
+//
+// Returns ErrIndexOutOfRange if k is not within [0, len(data)); the slice is then left untouched.
+func NthElement[T cmp.Ordered](data []T, k int) error {
+	return NthElementFunc(data, k, cmp.Less[T])
+}
+
+// NthElementFunc rearranges a slice like NthElement using the order defined by less.
+//
+// The selection is an introselect:
+//
+// 1. Partition the range three ways around a median-of-three or ninther pivot and continue only
+// in the part that contains k; stop as soon as k falls into the run of elements equal to the pivot;
+//
+// 2. Ranges shorter than the insertion-sort cutoff are finished with insertion sort;
+//
+// 3. If two rounds in a row have not halved the range, or after 2*log2(n) rounds, switch to
+// median-of-medians pivots, which guarantee that every round discards at least 30% of the range.
+// Until then the range halves at least every two rounds, so the adaptive rounds take O(n) time in total;
+//
+// 4. Runs in O(n) expected and O(n) worst-case time and O(log n) extra space.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the zero-based rank to place;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns ErrIndexOutOfRange if k is not within [0, len(data)); the slice is then left untouched.
+func NthElementFunc[T any](data []T, k int, less func(a, b T) bool) error {
+	if err := checkRank(k, len(data)); err != nil {
+		return err
+	}
+
+	newSorter(data, less).introSelect(0, len(data), k)
+
+	return nil
+}
+
+// NthElementMedianOfMedians rearranges a slice like NthElementFunc, but picks every pivot with the
+// median-of-medians rule (Blum, Floyd, Pratt, Rivest and Tarjan).
+//
+// It is deterministic and linear in the worst case on every input, at the price of a larger constant
+// factor than the introselect used by NthElementFunc.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the zero-based rank to place;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns ErrIndexOutOfRange if k is not within [0, len(data)); the slice is then left untouched.
+func NthElementMedianOfMedians[T any](data []T, k int, less func(a, b T) bool) error {
+	if err := checkRank(k, len(data)); err != nil {
+		return err
+	}
+
+	newSorter(data, less).selectRange(0, len(data), k, 0)
+
+	return nil
+}
+
+// checkRank returns ErrIndexOutOfRange unless k is a valid index into a slice of length n.
+func checkRank(k, n int) error {
+	if k < 0 || k >= n {
+		return fmt.Errorf("%w: rank %d, length %d", ErrIndexOutOfRange, k, n)
+	}
+
+	return nil
+}
+
+// introSelect places the k-th smallest element of data[lo:hi] at index k.
+func (s *sorter[T]) introSelect(lo, hi, k int) {
+	s.selectRange(lo, hi, k, depthLimit(hi-lo))
+}
+
+// selectRange places the k-th smallest element of data[lo:hi] at index k, partitioning data[lo:hi]
+// around it. Once budget is exhausted, or two rounds have not halved the range, every pivot is chosen
+// with medianOfMedians.
+func (s *sorter[T]) selectRange(lo, hi, k, budget int) {
+	// size is the length of the range two rounds ago.
+	size, rounds := hi-lo, 0
+
+	for hi-lo > insertionSortCutoff {
+		var p int
+		if budget > 0 {
+			budget--
+			p = s.choosePivot(lo, hi, PivotAdaptive)
+		} else {
+			p = s.medianOfMedians(lo, hi)
+		}
+
+		lt, gt := s.partition3(lo, hi, p)
+		s.partitioned(lo, lt, gt, hi)
+
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return
+		}
+
+		if rounds++; rounds == 2 {
+			if 2*(hi-lo) > size {
+				budget = 0
+			}
+
+			size, rounds = hi-lo, 0
+		}
+	}
+
+	s.insertionSort(lo, hi)
+}
+
+// selectRanks places the elements of the sorted ranks ks at their indices, as if NthElement were
+// called for each of them, while partitioning data[lo:hi] only O(log len(ks)) times per element.
+func (s *sorter[T]) selectRanks(lo, hi int, ks []int, budget int) {
+	for len(ks) > 0 {
+		if len(ks) == 1 || hi-lo <= insertionSortCutoff {
+			if len(ks) == 1 {
+				s.selectRange(lo, hi, ks[0], budget)
+			} else {
+				s.insertionSort(lo, hi)
+			}
+
+			return
+		}
+
+		var p int
+		if budget > 0 {
+			budget--
+			p = s.choosePivot(lo, hi, PivotAdaptive)
+		} else {
+			p = s.medianOfMedians(lo, hi)
+		}
+
+		lt, gt := s.partition3(lo, hi, p)
+		s.partitioned(lo, lt, gt, hi)
+
+		left, _ := slices.BinarySearch(ks, lt)
+		right, _ := slices.BinarySearch(ks, gt)
+
+		s.selectRanks(lo, lt, ks[:left], budget)
+		lo, ks = gt, ks[right:]
+	}
+}
+
+// medianOfMedians returns the index of a pivot for data[lo:hi] that has at least 30% of the range
+// on each side of it.
+//
+// 1. Sort every group of five elements and move its median to the front of the range;
+//
+// 2. Select the median of those medians recursively, again with median-of-medians pivots.
+func (s *sorter[T]) medianOfMedians(lo, hi int) int {
+	medians := lo
+
+	for group := lo; group < hi; group += medianGroupSize {
+		end := min(group+medianGroupSize, hi)
+		s.insertionSort(group, end)
+		s.swap(medians, group+(end-group)/2)
+		medians++
+	}
+
+	mid := lo + (medians-lo)/2
+	s.selectRange(lo, medians, mid, 0)
+
+	return mid
+}
@@ -0,0 +1,42 @@
+package sort
+
+import "cmp"
+
+// SelectionSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func SelectionSort[T cmp.Ordered](data []T) {
+	SelectionSortFunc(data, cmp.Less[T])
+}
+
+// SelectionSortFunc sorts a slice in place with selection sort using the order defined by less.
+//
+// 1. Each pass finds the smallest element of the unsorted suffix and swaps it to the front of the suffix;
+//
+// 2. Always uses n(n-1)/2 comparisons but at most n-1 swaps, which suits data that is expensive to move;
+//
+// 3. Runs in O(n^2) time and O(1) extra space. The sort is not stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func SelectionSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).selectionSort()
+}
+
+// selectionSort sorts the whole slice with selection sort.
+func (s *sorter[T]) selectionSort() {
+	for i := 0; i+1 < len(s.data); i++ {
+		smallest := i
+		for j := i + 1; j < len(s.data); j++ {
+			if s.lessAt(j, smallest) {
+				smallest = j
+			}
+		}
+
+		if smallest != i {
+			s.swap(i, smallest)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package sort
+
+// insertionSortCutoff is the length below which the divide and conquer sorts switch to insertion sort.
+const insertionSortCutoff = 12
+
+// sorter bundles a slice with its ordering so that all algorithms share the same element access helpers.
+//
+// Every access that a Tracer can observe goes through these helpers. Without a tracer a helper
+// only pays for a nil check, which is perfectly predicted, so untraced sorts run at full speed.
+//
+// Fields:
+//   - data: the slice being sorted;
+//   - less: a function that returns true if a must be ordered before b;
+//   - tracer: the observer of the operations, nil when tracing is disabled.
+type sorter[T any] struct {
+	data   []T
+	less   func(a, b T) bool
+	tracer Tracer[T]
+}
+
+// newSorter creates a sorter over data ordered by less, without a tracer.
+func newSorter[T any](data []T, less func(a, b T) bool) *sorter[T] {
+	return &sorter[T]{data: data, less: less}
+}
+
+// lessAt compares the elements at indices i and j.
+func (s *sorter[T]) lessAt(i, j int) bool {
+	if s.tracer != nil {
+		s.tracer.Compare(i, j)
+	}
+
+	return s.less(s.data[i], s.data[j])
+}
+
+// lessValues compares two values that were read from indices i and j, e.g. after one of them
+// was moved to a scratch buffer.
+func (s *sorter[T]) lessValues(a T, i int, b T, j int) bool {
+	if s.tracer != nil {
+		s.tracer.Compare(i, j)
+	}
+
+	return s.less(a, b)
+}
+
+// swap swaps the elements at indices i and j.
+func (s *sorter[T]) swap(i, j int) {
+	if s.tracer != nil {
+		s.tracer.Swap(i, j)
+	}
+
+	s.data[i], s.data[j] = s.data[j], s.data[i]
+}
+
+// write stores value at index i.
+func (s *sorter[T]) write(i int, value T) {
+	if s.tracer != nil {
+		s.tracer.Write(i, value)
+	}
+
+	s.data[i] = value
+}
+
+// writeAll copies values into data starting at index i.
+func (s *sorter[T]) writeAll(i int, values []T) {
+	if s.tracer == nil {
+		copy(s.data[i:], values)
+		return
+	}
+
+	for k, value := range values {
+		s.write(i+k, value)
+	}
+}
+
+// move copies data[src:src+n] to data[dst:dst+n]; the ranges may overlap.
+func (s *sorter[T]) move(dst, src, n int) {
+	if s.tracer == nil {
+		copy(s.data[dst:dst+n], s.data[src:src+n])
+		return
+	}
+
+	if dst < src {
+		for k := 0; k < n; k++ {
+			s.write(dst+k, s.data[src+k])
+		}
+	} else {
+		for k := n - 1; k >= 0; k-- {
+			s.write(dst+k, s.data[src+k])
+		}
+	}
+}
+
+// shiftRight moves data[lo:hi] one position to the right, overwriting data[hi].
+func (s *sorter[T]) shiftRight(lo, hi int) {
+	s.move(lo+1, lo, hi-lo)
+}
+
+// partitioned reports that data[lo:hi] was split into data[lo:lt], data[lt:gt] and data[gt:hi].
+func (s *sorter[T]) partitioned(lo, lt, gt, hi int) {
+	if s.tracer != nil {
+		s.tracer.Partition(lo, lt, gt, hi)
+	}
+}
+
+// insertionSort sorts data[lo:hi] by insertion; it is stable and fast for short or nearly sorted ranges.
+func (s *sorter[T]) insertionSort(lo, hi int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && s.lessAt(j, j-1); j-- {
+			s.swap(j, j-1)
+		}
+	}
+}
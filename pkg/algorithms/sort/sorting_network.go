@@ -0,0 +1,192 @@
+package sort
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+)
+
+// MaxNetworkSize is the largest number of inputs a SortingNetwork can be built for.
+const MaxNetworkSize = 1 << 16
+
+// Comparator is a compare-exchange element of a sorting network: after it is applied,
+// data[I] is not greater than data[J]. I is always less than J.
+type Comparator struct {
+	I, J int
+}
+
+// SortingNetwork is a fixed sequence of compare-exchange operations that sorts every input of a given size.
+//
+// The comparators are grouped into layers. The comparators of a layer touch disjoint indices,
+// so they are independent of each other and may run in any order or concurrently; the number
+// of layers is the depth of the network.
+//
+// Fields:
+//   - Size: the number of inputs;
+//   - Layers: the comparators, layer by layer.
+type SortingNetwork struct {
+	Size   int
+	Layers [][]Comparator
+}
+
+// BitonicNetwork builds Batcher's bitonic sorting network for n inputs.
+//
+// 1. Build the network for the next power of two N. A block of length k is sorted by sorting both
+// halves and merging them; the first merge layer compares mirrored positions i and i^(k-1), which
+// turns the two ascending halves into a bitonic sequence, and the following layers compare i and i^d
+// for d = k/4, ..., 1;
+//
+// 2. Drop the comparators that touch the padding positions n..N-1. Every comparator moves the minimum
+// to its lower index, so padding with values greater than everything else never moves and those
+// comparators never exchange anything;
+//
+// 3. The network has at most (log2 N)(log2 N + 1)/2 layers and O(n log^2 n) comparators.
+//
+// Parameters:
+//   - n: the number of inputs, within [0, MaxNetworkSize].
+//
+// Returns the network and ErrNetworkSize if n is out of range.
+func BitonicNetwork(n int) (*SortingNetwork, error) {
+	size, err := paddedNetworkSize(n)
+	if err != nil {
+		return nil, err
+	}
+
+	network := &SortingNetwork{Size: n}
+
+	for k := 2; k <= size; k *= 2 {
+		network.addLayer(size, func(i int) int { return i ^ (k - 1) })
+
+		for d := k / 4; d >= 1; d /= 2 {
+			network.addLayer(size, func(i int) int { return i ^ d })
+		}
+	}
+
+	return network, nil
+}
+
+// OddEvenMergeNetwork builds Batcher's odd-even merge sorting network for n inputs.
+//
+// 1. Build the network for the next power of two N. Blocks of length 2p are merged from two sorted
+// halves by comparing elements k = p, p/2, ..., 1 apart, restricted to pairs inside the same block and,
+// for k < p, to pairs that start at an odd multiple of k inside it;
+//
+// 2. Drop the comparators that touch the padding positions, as in BitonicNetwork;
+//
+// 3. The network has the same depth as the bitonic network but fewer comparators,
+// e.g. 19 instead of 24 for 8 inputs and 63 instead of 80 for 16.
+//
+// Parameters:
+//   - n: the number of inputs, within [0, MaxNetworkSize].
+//
+// Returns the network and ErrNetworkSize if n is out of range.
+func OddEvenMergeNetwork(n int) (*SortingNetwork, error) {
+	size, err := paddedNetworkSize(n)
+	if err != nil {
+		return nil, err
+	}
+
+	network := &SortingNetwork{Size: n}
+
+	for p := 1; p < size; p *= 2 {
+		for k := p; k >= 1; k /= 2 {
+			var layer []Comparator
+
+			for j := k % p; j+k < size; j += 2 * k {
+				for i := 0; i < k && i+j+k < size; i++ {
+					lo, hi := i+j, i+j+k
+					if lo/(2*p) == hi/(2*p) && hi < n {
+						layer = append(layer, Comparator{I: lo, J: hi})
+					}
+				}
+			}
+
+			if len(layer) > 0 {
+				network.Layers = append(network.Layers, layer)
+			}
+		}
+	}
+
+	return network, nil
+}
+
+// Comparators returns the total number of comparators of the network.
+func (s *SortingNetwork) Comparators() int {
+	total := 0
+	for _, layer := range s.Layers {
+		total += len(layer)
+	}
+
+	return total
+}
+
+// Depth returns the number of layers of the network.
+func (s *SortingNetwork) Depth() int {
+	return len(s.Layers)
+}
+
+// addLayer adds the comparators (i, partner(i)) with i < partner(i) < s.Size for every i of the padded size.
+func (s *SortingNetwork) addLayer(size int, partner func(i int) int) {
+	var layer []Comparator
+
+	for i := 0; i < size; i++ {
+		if j := partner(i); i < j && j < s.Size {
+			layer = append(layer, Comparator{I: i, J: j})
+		}
+	}
+
+	if len(layer) > 0 {
+		s.Layers = append(s.Layers, layer)
+	}
+}
+
+// paddedNetworkSize returns the smallest power of two not less than n.
+func paddedNetworkSize(n int) (int, error) {
+	if n < 0 || n > MaxNetworkSize {
+		return 0, fmt.Errorf("%w: %d inputs, limit is %d", ErrNetworkSize, n, MaxNetworkSize)
+	}
+
+	if n <= 1 {
+		return n, nil
+	}
+
+	return 1 << bits.Len(uint(n-1)), nil
+}
+
+// SortWithNetwork sorts a slice of ordered values in ascending order by applying a sorting network.
+//
+// Parameters:
+//   - network: the network, built for len(data) inputs;
+//   - data: the slice to sort in place.
+//
+// Returns ErrNetworkSize if the network was built for a different number of inputs.
+func SortWithNetwork[T cmp.Ordered](network *SortingNetwork, data []T) error {
+	return SortWithNetworkFunc(network, data, cmp.Less[T])
+}
+
+// SortWithNetworkFunc sorts a slice in place by applying a sorting network with the order defined by less.
+//
+// The sequence of comparisons does not depend on the data, which makes networks suitable for
+// short fixed-size inputs and for implementations that evaluate a layer at once. The sort is not stable.
+//
+// Parameters:
+//   - network: the network, built for len(data) inputs;
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+//
+// Returns ErrNetworkSize if the network was built for a different number of inputs.
+func SortWithNetworkFunc[T any](network *SortingNetwork, data []T, less func(a, b T) bool) error {
+	if network.Size != len(data) {
+		return fmt.Errorf("%w: network sorts %d inputs, got %d", ErrNetworkSize, network.Size, len(data))
+	}
+
+	for _, layer := range network.Layers {
+		for _, c := range layer {
+			if less(data[c.J], data[c.I]) {
+				data[c.I], data[c.J] = data[c.J], data[c.I]
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,457 @@
+package sort
+
+import "cmp"
+
+const (
+	// timMinMerge is the length below which TimSort sorts the whole slice with binary insertion.
+	timMinMerge = 64
+	// timMinGallop is the initial number of consecutive wins of one run that switches a merge to galloping.
+	timMinGallop = 7
+)
+
+// TimSort sorts a slice of ordered values in ascending order.
+//
+// Parameters:
+//   - data: the slice to sort in place.
+func TimSort[T cmp.Ordered](data []T) {
+	TimSortFunc(data, cmp.Less[T])
+}
+
+// TimSortFunc sorts a slice in place with TimSort using the order defined by less.
+//
+// 1. The slice is scanned for natural runs; strictly descending runs are reversed and runs shorter
+// than minrun are extended with binary insertion sort. Minrun is chosen between 32 and 64 so that
+// the number of runs is a power of two or slightly less, which keeps the merges balanced;
+//
+// 2. Runs are pushed on a stack whose lengths must grow faster than the Fibonacci numbers from the
+// top down; when the invariant breaks, neighbouring runs are merged, so merges stay balanced and the
+// stack depth stays O(log n);
+//
+// 3. Before a merge the parts of both runs that are already in place are skipped with a binary
+// search. During a merge, when one run wins many comparisons in a row, the merge switches to
+// galloping: exponential search finds how many elements can be copied in one go. The threshold
+// adapts to how well galloping has been paying off;
+//
+// 4. Runs in O(n) time on sorted, reversed or few-run input, O(n log n) in the worst case,
+// and uses at most n/2 elements of scratch space. The sort is stable.
+//
+// Parameters:
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b.
+func TimSortFunc[T any](data []T, less func(a, b T) bool) {
+	newSorter(data, less).timSort()
+}
+
+// timRun is a sorted run on the TimSort stack.
+type timRun struct {
+	base   int
+	length int
+}
+
+// timSorter holds the state of one TimSort call.
+//
+// Fields:
+//   - s: the sorter being sorted;
+//   - runs: the stack of pending runs;
+//   - minGallop: the current number of consecutive wins that switches a merge to galloping;
+//   - buf: the scratch buffer, grown on demand.
+type timSorter[T any] struct {
+	s         *sorter[T]
+	runs      []timRun
+	minGallop int
+	buf       []T
+}
+
+// timSort sorts the whole slice with TimSort.
+func (s *sorter[T]) timSort() {
+	n := len(s.data)
+	if n < 2 {
+		return
+	}
+
+	if n < timMinMerge {
+		s.binaryInsertionSortFrom(0, s.countRun(0, n), n)
+		return
+	}
+
+	t := &timSorter[T]{s: s, minGallop: timMinGallop}
+	minRun := timMinRun(n)
+
+	for lo := 0; lo < n; {
+		hi := s.countRun(lo, n)
+
+		if hi-lo < minRun {
+			end := min(lo+minRun, n)
+			s.binaryInsertionSortFrom(lo, hi, end)
+			hi = end
+		}
+
+		t.runs = append(t.runs, timRun{base: lo, length: hi - lo})
+		t.mergeCollapse()
+		lo = hi
+	}
+
+	t.mergeForceCollapse()
+}
+
+// timMinRun returns the minimum run length for a slice of length n: n itself below timMinMerge,
+// otherwise the six most significant bits of n, plus one if any of the remaining bits is set.
+func timMinRun(n int) int {
+	r := 0
+	for n >= timMinMerge {
+		r |= n & 1
+		n >>= 1
+	}
+
+	return n + r
+}
+
+// mergeCollapse merges runs until the stack invariants hold again:
+// runs[i-2] > runs[i-1] + runs[i] and runs[i-1] > runs[i] for the top runs.
+//
+// The check includes the fourth run from the top, which fixes the invariant violation
+// found in the original algorithm by de Gouw et al.
+func (t *timSorter[T]) mergeCollapse() {
+	for len(t.runs) > 1 {
+		n := len(t.runs) - 2
+
+		if (n > 0 && t.runs[n-1].length <= t.runs[n].length+t.runs[n+1].length) ||
+			(n > 1 && t.runs[n-2].length <= t.runs[n-1].length+t.runs[n].length) {
+			if t.runs[n-1].length < t.runs[n+1].length {
+				n--
+			}
+		} else if t.runs[n].length > t.runs[n+1].length {
+			return
+		}
+
+		t.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges all remaining runs.
+func (t *timSorter[T]) mergeForceCollapse() {
+	for len(t.runs) > 1 {
+		n := len(t.runs) - 2
+		if n > 0 && t.runs[n-1].length < t.runs[n+1].length {
+			n--
+		}
+
+		t.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at stack positions i and i+1.
+func (t *timSorter[T]) mergeAt(i int) {
+	s := t.s
+	baseA, lenA := t.runs[i].base, t.runs[i].length
+	baseB, lenB := t.runs[i+1].base, t.runs[i+1].length
+
+	t.runs[i].length = lenA + lenB
+	t.runs = append(t.runs[:i+1], t.runs[i+2:]...)
+
+	s.partitioned(baseA, baseB, baseB, baseB+lenB)
+
+	// Elements of A that are not greater than the first element of B are already in place.
+	k := t.gallopRight(s.data[baseB], baseB, s.data[baseA:baseA+lenA], baseA, 0)
+	baseA += k
+	lenA -= k
+	if lenA == 0 {
+		return
+	}
+
+	// Elements of B that are not smaller than the last element of A are already in place.
+	lenB = t.gallopLeft(s.data[baseA+lenA-1], baseA+lenA-1, s.data[baseB:baseB+lenB], baseB, lenB-1)
+	if lenB == 0 {
+		return
+	}
+
+	if lenA <= lenB {
+		t.mergeLo(baseA, lenA, baseB, lenB)
+	} else {
+		t.mergeHi(baseA, lenA, baseB, lenB)
+	}
+}
+
+// scratch returns a scratch buffer of length n.
+func (t *timSorter[T]) scratch(n int) []T {
+	if cap(t.buf) < n {
+		t.buf = make([]T, max(n, min(2*cap(t.buf), len(t.s.data)/2)))
+	}
+
+	return t.buf[:n]
+}
+
+// gallopLeft returns the position k in a such that a[k-1] < key <= a[k], searching exponentially
+// from hint. Elements of a are reported to the tracer at origin+index, key at keyAt.
+func (t *timSorter[T]) gallopLeft(key T, keyAt int, a []T, origin, hint int) int {
+	less := func(i int) bool { return t.s.lessValues(a[i], origin+i, key, keyAt) }
+	lastOfs, ofs := 0, 1
+
+	if less(hint) {
+		// a[hint] < key: gallop right until a[hint+lastOfs] < key <= a[hint+ofs].
+		maxOfs := len(a) - hint
+		for ofs < maxOfs && less(hint+ofs) {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = lastOfs+hint, ofs+hint
+	} else {
+		// key <= a[hint]: gallop left until a[hint-ofs] < key <= a[hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && !less(hint-ofs) {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+
+	// a[lastOfs] < key <= a[ofs]: binary search in between.
+	for lastOfs++; lastOfs < ofs; {
+		mid := lastOfs + (ofs-lastOfs)/2
+		if less(mid) {
+			lastOfs = mid + 1
+		} else {
+			ofs = mid
+		}
+	}
+
+	return ofs
+}
+
+// gallopRight returns the position k in a such that a[k-1] <= key < a[k], searching exponentially
+// from hint. Elements of a are reported to the tracer at origin+index, key at keyAt.
+func (t *timSorter[T]) gallopRight(key T, keyAt int, a []T, origin, hint int) int {
+	greater := func(i int) bool { return t.s.lessValues(key, keyAt, a[i], origin+i) }
+	lastOfs, ofs := 0, 1
+
+	if greater(hint) {
+		// key < a[hint]: gallop left until a[hint-ofs] <= key < a[hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && greater(hint-ofs) {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	} else {
+		// a[hint] <= key: gallop right until a[hint+lastOfs] <= key < a[hint+ofs].
+		maxOfs := len(a) - hint
+		for ofs < maxOfs && !greater(hint+ofs) {
+			lastOfs = ofs
+			ofs = 2*ofs + 1
+		}
+
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = lastOfs+hint, ofs+hint
+	}
+
+	for lastOfs++; lastOfs < ofs; {
+		mid := lastOfs + (ofs-lastOfs)/2
+		if greater(mid) {
+			ofs = mid
+		} else {
+			lastOfs = mid + 1
+		}
+	}
+
+	return ofs
+}
+
+// mergeLo merges the neighbouring runs A = data[baseA:baseA+lenA] and B = data[baseB:baseB+lenB]
+// from the left, copying A to the scratch buffer; used when A is the shorter run.
+//
+// The first element of B is known to be smaller than the first element of A, and the last element
+// of A is known to be greater than the last element of B.
+func (t *timSorter[T]) mergeLo(baseA, lenA, baseB, lenB int) {
+	s := t.s
+	tmp := t.scratch(lenA)
+	copy(tmp, s.data[baseA:baseA+lenA])
+
+	dest, c1, c2 := baseA, 0, baseB
+
+	s.write(dest, s.data[c2])
+	dest, c2, lenB = dest+1, c2+1, lenB-1
+
+	minGallop := t.minGallop
+
+merging:
+	for lenB > 0 && lenA > 1 {
+		wins1, wins2 := 0, 0
+
+		for wins1|wins2 < minGallop {
+			if s.lessValues(s.data[c2], c2, tmp[c1], baseA+c1) {
+				s.write(dest, s.data[c2])
+				dest, c2, lenB = dest+1, c2+1, lenB-1
+				wins1, wins2 = 0, wins2+1
+
+				if lenB == 0 {
+					break merging
+				}
+			} else {
+				s.write(dest, tmp[c1])
+				dest, c1, lenA = dest+1, c1+1, lenA-1
+				wins1, wins2 = wins1+1, 0
+
+				if lenA == 1 {
+					break merging
+				}
+			}
+		}
+
+		// One run keeps winning: gallop until galloping stops paying off.
+		minGallop++
+		for {
+			minGallop = max(1, minGallop-1)
+
+			wins1 = t.gallopRight(s.data[c2], c2, tmp[c1:c1+lenA], baseA+c1, 0)
+			if wins1 > 0 {
+				s.writeAll(dest, tmp[c1:c1+wins1])
+				dest, c1, lenA = dest+wins1, c1+wins1, lenA-wins1
+
+				if lenA <= 1 {
+					break merging
+				}
+			}
+
+			s.write(dest, s.data[c2])
+			dest, c2, lenB = dest+1, c2+1, lenB-1
+			if lenB == 0 {
+				break merging
+			}
+
+			wins2 = t.gallopLeft(tmp[c1], baseA+c1, s.data[c2:c2+lenB], c2, 0)
+			if wins2 > 0 {
+				s.move(dest, c2, wins2)
+				dest, c2, lenB = dest+wins2, c2+wins2, lenB-wins2
+
+				if lenB == 0 {
+					break merging
+				}
+			}
+
+			s.write(dest, tmp[c1])
+			dest, c1, lenA = dest+1, c1+1, lenA-1
+			if lenA == 1 {
+				break merging
+			}
+
+			if wins1 < timMinGallop && wins2 < timMinGallop {
+				break
+			}
+		}
+
+		minGallop++
+	}
+
+	t.minGallop = max(1, minGallop)
+
+	if lenA == 1 {
+		// The last element of A is the greatest: move the rest of B and put it last.
+		s.move(dest, c2, lenB)
+		s.write(dest+lenB, tmp[c1])
+	} else {
+		s.writeAll(dest, tmp[c1:c1+lenA])
+	}
+}
+
+// mergeHi merges the neighbouring runs A = data[baseA:baseA+lenA] and B = data[baseB:baseB+lenB]
+// from the right, copying B to the scratch buffer; used when B is the shorter run.
+//
+// The first element of B is known to be smaller than the first element of A, and the last element
+// of A is known to be greater than the last element of B.
+func (t *timSorter[T]) mergeHi(baseA, lenA, baseB, lenB int) {
+	s := t.s
+	tmp := t.scratch(lenB)
+	copy(tmp, s.data[baseB:baseB+lenB])
+
+	dest, c1, c2 := baseB+lenB-1, baseA+lenA-1, lenB-1
+
+	s.write(dest, s.data[c1])
+	dest, c1, lenA = dest-1, c1-1, lenA-1
+
+	minGallop := t.minGallop
+
+merging:
+	for lenA > 0 && lenB > 1 {
+		wins1, wins2 := 0, 0
+
+		for wins1|wins2 < minGallop {
+			if s.lessValues(tmp[c2], baseB+c2, s.data[c1], c1) {
+				s.write(dest, s.data[c1])
+				dest, c1, lenA = dest-1, c1-1, lenA-1
+				wins1, wins2 = wins1+1, 0
+
+				if lenA == 0 {
+					break merging
+				}
+			} else {
+				s.write(dest, tmp[c2])
+				dest, c2, lenB = dest-1, c2-1, lenB-1
+				wins1, wins2 = 0, wins2+1
+
+				if lenB == 1 {
+					break merging
+				}
+			}
+		}
+
+		minGallop++
+		for {
+			minGallop = max(1, minGallop-1)
+
+			wins1 = lenA - t.gallopRight(tmp[c2], baseB+c2, s.data[baseA:baseA+lenA], baseA, lenA-1)
+			if wins1 > 0 {
+				dest, c1, lenA = dest-wins1, c1-wins1, lenA-wins1
+				s.move(dest+1, c1+1, wins1)
+
+				if lenA == 0 {
+					break merging
+				}
+			}
+
+			s.write(dest, tmp[c2])
+			dest, c2, lenB = dest-1, c2-1, lenB-1
+			if lenB == 1 {
+				break merging
+			}
+
+			wins2 = lenB - t.gallopLeft(s.data[c1], c1, tmp[:lenB], baseB, lenB-1)
+			if wins2 > 0 {
+				dest, c2, lenB = dest-wins2, c2-wins2, lenB-wins2
+				s.writeAll(dest+1, tmp[c2+1:c2+1+wins2])
+
+				if lenB <= 1 {
+					break merging
+				}
+			}
+
+			s.write(dest, s.data[c1])
+			dest, c1, lenA = dest-1, c1-1, lenA-1
+			if lenA == 0 {
+				break merging
+			}
+
+			if wins1 < timMinGallop && wins2 < timMinGallop {
+				break
+			}
+		}
+
+		minGallop++
+	}
+
+	t.minGallop = max(1, minGallop)
+
+	if lenB == 1 {
+		// The first element of B is the smallest: move the rest of A and put it first.
+		dest, c1 = dest-lenA, c1-lenA
+		s.move(dest+1, c1+1, lenA)
+		s.write(dest, tmp[c2])
+	} else {
+		s.writeAll(dest-lenB+1, tmp[:lenB])
+	}
+}
@@ -0,0 +1,364 @@
+package sort
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Tracer observes the elementary operations of a sort, for teaching and for comparing algorithms.
+//
+// Indices always refer to positions in the slice being sorted; merge sorts compare elements held
+// in a scratch buffer and report them by the index they were copied from. A tracer is called
+// synchronously from the goroutine running the sort. The parallel merge sort is the exception: it
+// calls the tracer from all its goroutines, one call at a time, so the events of independent ranges
+// interleave in no fixed order, but replaying them still reproduces the sort.
+type Tracer[T any] interface {
+	// Compare is called before the elements at indices i and j are compared.
+	Compare(i, j int)
+	// Swap is called before the elements at indices i and j are swapped.
+	Swap(i, j int)
+	// Write is called before value is stored at index i.
+	Write(i int, value T)
+	// Partition reports the boundaries of a divide step over data[lo:hi]. Quicksort calls it after
+	// partitioning, with data[lo:lt], data[lt:gt] and data[gt:hi] holding the elements smaller than,
+	// equal to and greater than the pivot; merge sorts call it before merging data[lo:lt] and
+	// data[gt:hi], with lt == gt.
+	Partition(lo, lt, gt, hi int)
+}
+
+// EventKind is the type of a traced operation.
+type EventKind int
+
+const (
+	// EventCompare is a comparison of two elements.
+	EventCompare EventKind = iota
+	// EventSwap is a swap of two elements.
+	EventSwap
+	// EventWrite is a store of a value into the slice.
+	EventWrite
+	// EventPartition is a split of a range into sub-ranges.
+	EventPartition
+)
+
+// String returns the name of the event kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventCompare:
+		return "compare"
+	case EventSwap:
+		return "swap"
+	case EventWrite:
+		return "write"
+	case EventPartition:
+		return "partition"
+	default:
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+}
+
+// Event is a single traced operation.
+//
+// Fields:
+//   - Kind: the type of the operation;
+//   - I, J: the indices of a compare or a swap; I is also the index of a write;
+//   - Value: the value stored by a write;
+//   - Bounds: the lo, lt, gt and hi boundaries of a partition.
+type Event[T any] struct {
+	Kind   EventKind
+	I, J   int
+	Value  T
+	Bounds [4]int
+}
+
+// String formats the event as a call, e.g. "compare(3, 5)" or "write(2, 7)".
+func (e Event[T]) String() string {
+	switch e.Kind {
+	case EventWrite:
+		return fmt.Sprintf("write(%d, %v)", e.I, e.Value)
+	case EventPartition:
+		return fmt.Sprintf("partition(%d, %d, %d, %d)", e.Bounds[0], e.Bounds[1], e.Bounds[2], e.Bounds[3])
+	default:
+		return fmt.Sprintf("%v(%d, %d)", e.Kind, e.I, e.J)
+	}
+}
+
+// Counter is a Tracer that counts operations.
+//
+// Fields:
+//   - Comparisons: the number of comparisons;
+//   - Swaps: the number of swaps;
+//   - Writes: the number of single-element writes;
+//   - Partitions: the number of partition steps.
+type Counter[T any] struct {
+	Comparisons int
+	Swaps       int
+	Writes      int
+	Partitions  int
+}
+
+// Compare counts a comparison.
+func (c *Counter[T]) Compare(_, _ int) { c.Comparisons++ }
+
+// Swap counts a swap.
+func (c *Counter[T]) Swap(_, _ int) { c.Swaps++ }
+
+// Write counts a write.
+func (c *Counter[T]) Write(_ int, _ T) { c.Writes++ }
+
+// Partition counts a partition step.
+func (c *Counter[T]) Partition(_, _, _, _ int) { c.Partitions++ }
+
+// Recorder is a Tracer that keeps every event so the sort can be replayed step by step.
+//
+// Fields:
+//   - Events: the recorded events in the order they happened.
+type Recorder[T any] struct {
+	Events []Event[T]
+}
+
+// Compare records a comparison.
+func (r *Recorder[T]) Compare(i, j int) {
+	r.Events = append(r.Events, Event[T]{Kind: EventCompare, I: i, J: j})
+}
+
+// Swap records a swap.
+func (r *Recorder[T]) Swap(i, j int) {
+	r.Events = append(r.Events, Event[T]{Kind: EventSwap, I: i, J: j})
+}
+
+// Write records a write.
+func (r *Recorder[T]) Write(i int, value T) {
+	r.Events = append(r.Events, Event[T]{Kind: EventWrite, I: i, Value: value})
+}
+
+// Partition records a partition step.
+func (r *Recorder[T]) Partition(lo, lt, gt, hi int) {
+	r.Events = append(r.Events, Event[T]{Kind: EventPartition, Bounds: [4]int{lo, lt, gt, hi}})
+}
+
+// Counts summarizes the recorded events.
+//
+// Returns a Counter with the number of events of each kind.
+func (r *Recorder[T]) Counts() Counter[T] {
+	var counts Counter[T]
+	for _, event := range r.Events {
+		switch event.Kind {
+		case EventCompare:
+			counts.Comparisons++
+		case EventSwap:
+			counts.Swaps++
+		case EventWrite:
+			counts.Writes++
+		case EventPartition:
+			counts.Partitions++
+		}
+	}
+
+	return counts
+}
+
+// Replay applies the recorded swaps and writes to a copy of the input the sort started from.
+//
+// Parameters:
+//   - original: the input as it was before sorting; it is not modified;
+//   - visit: called after every event with its position in Events and the state of the slice at
+//     that point; the state is reused between calls and must not be retained. May be nil.
+//
+// Returns the final state, which equals the sorted output when the trace is complete.
+func (r *Recorder[T]) Replay(original []T, visit func(step int, event Event[T], state []T)) []T {
+	state := make([]T, len(original))
+	copy(state, original)
+
+	for step, event := range r.Events {
+		switch event.Kind {
+		case EventSwap:
+			state[event.I], state[event.J] = state[event.J], state[event.I]
+		case EventWrite:
+			state[event.I] = event.Value
+		}
+
+		if visit != nil {
+			visit(step, event, state)
+		}
+	}
+
+	return state
+}
+
+// Algorithm identifies a comparison sort that can be run with a tracer by SortTraced.
+type Algorithm int
+
+const (
+	// AlgorithmQuick is QuickSortFunc.
+	AlgorithmQuick Algorithm = iota
+	// AlgorithmMerge is MergeSortFunc, the top-down merge sort.
+	AlgorithmMerge
+	// AlgorithmMergeBottomUp is the natural bottom-up merge sort of MergeSorter.
+	AlgorithmMergeBottomUp
+	// AlgorithmHeap is HeapSortFunc.
+	AlgorithmHeap
+	// AlgorithmBubble is BubbleSortFunc.
+	AlgorithmBubble
+	// AlgorithmCocktailShaker is CocktailShakerSortFunc.
+	AlgorithmCocktailShaker
+	// AlgorithmComb is CombSortFunc.
+	AlgorithmComb
+	// AlgorithmInsertion is InsertionSortFunc.
+	AlgorithmInsertion
+	// AlgorithmBinaryInsertion is BinaryInsertionSortFunc.
+	AlgorithmBinaryInsertion
+	// AlgorithmSelection is SelectionSortFunc.
+	AlgorithmSelection
+	// AlgorithmTim is TimSortFunc.
+	AlgorithmTim
+	// AlgorithmPDQ is PDQSortFunc.
+	AlgorithmPDQ
+	// AlgorithmMergeParallel is MergeSortParallel with GOMAXPROCS workers.
+	AlgorithmMergeParallel
+	// AlgorithmPartial is PartialSortFunc with k = len(data); PartialSortTraced traces other values of k.
+	AlgorithmPartial
+
+	algorithmCount
+)
+
+// algorithmNames holds the names of the algorithms, indexed by Algorithm.
+var algorithmNames = [algorithmCount]string{
+	AlgorithmQuick:           "quick",
+	AlgorithmMerge:           "merge",
+	AlgorithmMergeBottomUp:   "merge-bottom-up",
+	AlgorithmHeap:            "heap",
+	AlgorithmBubble:          "bubble",
+	AlgorithmCocktailShaker:  "cocktail-shaker",
+	AlgorithmComb:            "comb",
+	AlgorithmInsertion:       "insertion",
+	AlgorithmBinaryInsertion: "binary-insertion",
+	AlgorithmSelection:       "selection",
+	AlgorithmTim:             "tim",
+	AlgorithmPDQ:             "pdq",
+	AlgorithmMergeParallel:   "merge-parallel",
+	AlgorithmPartial:         "partial",
+}
+
+// String returns the name of the algorithm.
+func (a Algorithm) String() string {
+	if a < 0 || a >= algorithmCount {
+		return fmt.Sprintf("Algorithm(%d)", int(a))
+	}
+
+	return algorithmNames[a]
+}
+
+// Algorithms returns every algorithm supported by SortTraced.
+func Algorithms() []Algorithm {
+	algorithms := make([]Algorithm, algorithmCount)
+	for i := range algorithms {
+		algorithms[i] = Algorithm(i)
+	}
+
+	return algorithms
+}
+
+// SortTraced sorts data with the given algorithm and reports every operation to tracer.
+//
+// Parameters:
+//   - algorithm: the sort to run;
+//   - data: the slice to sort in place;
+//   - less: a function that returns true if a must be ordered before b;
+//   - tracer: the observer of the operations; nil runs the sort untraced.
+//
+// Returns ErrUnknownAlgorithm if the algorithm is not supported.
+func SortTraced[T any](algorithm Algorithm, data []T, less func(a, b T) bool, tracer Tracer[T]) error {
+	s := &sorter[T]{data: data, less: less, tracer: tracer}
+	n := len(data)
+
+	switch algorithm {
+	case AlgorithmQuick:
+		s.quickSort(0, n, depthLimit(n), PivotAdaptive)
+	case AlgorithmMerge:
+		s.mergeSortTopDown(make([]T, n), 0, n)
+	case AlgorithmMergeBottomUp:
+		s.mergeSortBottomUp(make([]T, n))
+	case AlgorithmHeap:
+		s.heapSort(0, n)
+	case AlgorithmBubble:
+		s.bubbleSort()
+	case AlgorithmCocktailShaker:
+		s.cocktailShakerSort()
+	case AlgorithmComb:
+		s.combSort()
+	case AlgorithmInsertion:
+		s.insertionSort(0, n)
+	case AlgorithmBinaryInsertion:
+		s.binaryInsertionSort(0, n)
+	case AlgorithmSelection:
+		s.selectionSort()
+	case AlgorithmTim:
+		s.timSort()
+	case AlgorithmPDQ:
+		s.pdqSort()
+	case AlgorithmMergeParallel:
+		m := NewMergeSorter(less, MergeParallel)
+		m.Tracer = tracer
+		m.Sort(data)
+	case AlgorithmPartial:
+		s.partialSort(n)
+	default:
+		return fmt.Errorf("%w: %v", ErrUnknownAlgorithm, algorithm)
+	}
+
+	return nil
+}
+
+// PartialSortTraced rearranges a slice like PartialSortFunc and reports every operation to tracer.
+//
+// Parameters:
+//   - data: the slice to rearrange in place;
+//   - k: the number of smallest elements to sort; clamped to [0, len(data)];
+//   - less: a function that returns true if a must be ordered before b;
+//   - tracer: the observer of the operations; nil runs the sort untraced.
+func PartialSortTraced[T any](data []T, k int, less func(a, b T) bool, tracer Tracer[T]) {
+	s := &sorter[T]{data: data, less: less, tracer: tracer}
+	s.partialSort(k)
+}
+
+// syncTracer serializes the calls that several goroutines make to a Tracer.
+//
+// Fields:
+//   - mu: guards the calls to tracer;
+//   - tracer: the wrapped tracer.
+type syncTracer[T any] struct {
+	mu     sync.Mutex
+	tracer Tracer[T]
+}
+
+// Compare forwards a comparison to the wrapped tracer.
+func (t *syncTracer[T]) Compare(i, j int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer.Compare(i, j)
+}
+
+// Swap forwards a swap to the wrapped tracer.
+func (t *syncTracer[T]) Swap(i, j int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer.Swap(i, j)
+}
+
+// Write forwards a write to the wrapped tracer.
+func (t *syncTracer[T]) Write(i int, value T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer.Write(i, value)
+}
+
+// Partition forwards a partition step to the wrapped tracer.
+func (t *syncTracer[T]) Partition(lo, lt, gt, hi int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer.Partition(lo, lt, gt, hi)
+}
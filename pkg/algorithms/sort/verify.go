@@ -0,0 +1,72 @@
+package sort
+
+import (
+	"cmp"
+	"slices"
+)
+
+// IsSorted reports whether a slice of ordered values is sorted in ascending order.
+//
+// NaN values are ordered before all other floating-point values, as with cmp.Less.
+func IsSorted[T cmp.Ordered](data []T) bool {
+	return IsSortedFunc(data, cmp.Less[T])
+}
+
+// IsSortedFunc reports whether a slice is sorted by less, i.e. no element is less than the one before it.
+func IsSortedFunc[T any](data []T, less func(a, b T) bool) bool {
+	return UnsortedIndex(data, less) < 0
+}
+
+// UnsortedIndex returns the first index i such that data[i] is less than data[i-1], or -1 if the
+// slice is sorted by less. It is useful to report where a sort went wrong.
+func UnsortedIndex[T any](data []T, less func(a, b T) bool) int {
+	for i := 1; i < len(data); i++ {
+		if less(data[i], data[i-1]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IsStableSorted reports whether sorted is the stable sort of original by less: it is sorted,
+// it holds the same elements and equal elements keep their order from original.
+//
+// Parameters:
+//   - original: the input before sorting;
+//   - sorted: the output to check;
+//   - less: a function that returns true if a must be ordered before b.
+func IsStableSorted[T comparable](original, sorted []T, less func(a, b T) bool) bool {
+	return IsStableSortedFunc(original, sorted, less, func(a, b T) bool { return a == b })
+}
+
+// IsStableSortedFunc reports whether sorted is the stable sort of original by less, comparing
+// elements for identity with equal.
+//
+// The stable sort of a slice is unique, so sorted is compared with the result of slices.SortStableFunc,
+// which is independent of the sorts of this package.
+//
+// Parameters:
+//   - original: the input before sorting;
+//   - sorted: the output to check;
+//   - less: a function that returns true if a must be ordered before b;
+//   - equal: a function that returns true if a and b are the same element.
+func IsStableSortedFunc[T any](original, sorted []T, less func(a, b T) bool, equal func(a, b T) bool) bool {
+	if len(original) != len(sorted) {
+		return false
+	}
+
+	expected := slices.Clone(original)
+	slices.SortStableFunc(expected, func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return slices.EqualFunc(expected, sorted, equal)
+}
@@ -0,0 +1,333 @@
+package data_structures_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBalanceCheck struct {
+	testName       string
+	checker        *data_structures.BalanceChecker
+	src            string
+	expectedIssues []string
+}
+
+type testBalanceFix struct {
+	testName           string
+	checker            *data_structures.BalanceChecker
+	src                string
+	expectedFixed      string
+	expectedInsertions int
+}
+
+func templateChecker() *data_structures.BalanceChecker {
+	checker := data_structures.NewBalanceChecker(
+		data_structures.DelimiterPair{Open: "{{", Close: "}}"},
+		data_structures.DelimiterPair{Open: "{", Close: "}"},
+		data_structures.DelimiterPair{Open: "(", Close: ")"},
+		data_structures.DelimiterPair{Open: "[", Close: "]"},
+	)
+	checker.Quotes = []string{`"`, `'`}
+
+	return checker
+}
+
+func TestBalanceChecker_Check(t *testing.T) {
+	tests := []testBalanceCheck{
+		{
+			testName:       "Empty text is balanced",
+			checker:        data_structures.NewBalanceChecker(),
+			src:            "",
+			expectedIssues: nil,
+		},
+		{
+			testName:       "Nested default delimiters are balanced",
+			checker:        data_structures.NewBalanceChecker(),
+			src:            "f(a[1], {b: (c)})",
+			expectedIssues: nil,
+		},
+		{
+			testName: "Unexpected closing delimiter",
+			checker:  data_structures.NewBalanceChecker(),
+			src:      "a + b)",
+			expectedIssues: []string{
+				`line 1, column 6: unexpected ")", no delimiter is open`,
+			},
+		},
+		{
+			testName: "Mismatched closing delimiter",
+			checker:  data_structures.NewBalanceChecker(),
+			src:      "(a]",
+			expectedIssues: []string{
+				`line 1, column 1: "(" is never closed, expected ")"`,
+				`line 1, column 3: found "]" but expected ")" to close delimiter opened at line 1, column 1`,
+			},
+		},
+		{
+			testName: "Unclosed delimiters on several lines",
+			checker:  data_structures.NewBalanceChecker(),
+			src:      "{\n  [1, 2,\n  3\n",
+			expectedIssues: []string{
+				`line 1, column 1: "{" is never closed, expected "}"`,
+				`line 2, column 3: "[" is never closed, expected "]"`,
+			},
+		},
+		{
+			testName: "Recovery after closing a deeper delimiter",
+			checker:  data_structures.NewBalanceChecker(),
+			src:      "{ ( }\n)",
+			expectedIssues: []string{
+				`line 1, column 5: found "}" but expected ")" to close delimiter opened at line 1, column 3`,
+				`line 2, column 1: unexpected ")", no delimiter is open`,
+			},
+		},
+		{
+			testName:       "Multi-character template delimiters",
+			checker:        templateChecker(),
+			src:            "Hello {{ user.name }}, {{ range(items) }}",
+			expectedIssues: nil,
+		},
+		{
+			testName: "Unclosed template delimiter",
+			checker:  templateChecker(),
+			src:      "Hello {{ user.name }, bye",
+			expectedIssues: []string{
+				`line 1, column 7: "{{" is never closed, expected "}}"`,
+				`line 1, column 20: found "}" but expected "}}" to close delimiter opened at line 1, column 7`,
+			},
+		},
+		{
+			testName:       "Delimiters inside quotes are ignored",
+			checker:        templateChecker(),
+			src:            `{{ print "}} (" 'x]' }}`,
+			expectedIssues: nil,
+		},
+		{
+			testName:       "Escaped delimiters and quotes are ignored",
+			checker:        templateChecker(),
+			src:            `\{ a \) "say \"hi\" )" }`,
+			expectedIssues: []string{`line 1, column 24: unexpected "}", no delimiter is open`},
+		},
+		{
+			testName:       "Unterminated quote",
+			checker:        templateChecker(),
+			src:            "{{ 'abc }}",
+			expectedIssues: []string{`line 1, column 1: "{{" is never closed, expected "}}"`, `line 1, column 4: quote "'" is never closed`},
+		},
+		{
+			testName:       "Symmetric delimiters",
+			checker:        data_structures.NewBalanceChecker(data_structures.DelimiterPair{Open: "|", Close: "|"}, data_structures.DelimiterPair{Open: "(", Close: ")"}),
+			src:            "|a (|b|) c|",
+			expectedIssues: nil,
+		},
+		{
+			testName: "Unicode columns are counted in runes",
+			checker:  data_structures.NewBalanceChecker(),
+			src:      "привет)",
+			expectedIssues: []string{
+				`line 1, column 7: unexpected ")", no delimiter is open`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			issues := test.checker.Check(test.src)
+
+			var messages []string
+			for _, issue := range issues {
+				messages = append(messages, issue.String())
+			}
+
+			assert.Equal(t, test.expectedIssues, messages)
+			assert.Equal(t, len(test.expectedIssues) == 0, test.checker.IsBalanced(test.src))
+		})
+	}
+}
+
+func TestBalanceChecker_IssueDetails(t *testing.T) {
+	issues := templateChecker().Check("x\n  {{ a )")
+
+	assert.Len(t, issues, 2)
+	assert.Equal(t, data_structures.IssueUnclosed, issues[0].Kind)
+	assert.Equal(t, data_structures.IssueMismatchedClose, issues[1].Kind)
+	assert.Equal(t, data_structures.Position{Offset: 9, Line: 2, Column: 8}, issues[1].Position)
+	assert.Equal(t, ")", issues[1].Found)
+	assert.Equal(t, "}}", issues[1].Expected)
+	assert.Equal(t, data_structures.Position{Offset: 4, Line: 2, Column: 3}, issues[1].OpenedAt)
+}
+
+func TestBalanceChecker_SuggestFix(t *testing.T) {
+	tests := []testBalanceFix{
+		{
+			testName:           "Balanced text is unchanged",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                "(a[b])",
+			expectedFixed:      "(a[b])",
+			expectedInsertions: 0,
+		},
+		{
+			testName:           "Missing opening delimiter goes to the start of the group",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                "a + b)",
+			expectedFixed:      "(a + b)",
+			expectedInsertions: 1,
+		},
+		{
+			testName:           "Missing closing delimiter goes to the end of the group",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                "[f(x]",
+			expectedFixed:      "[f(x)]",
+			expectedInsertions: 1,
+		},
+		{
+			testName:           "Several unclosed delimiters",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                "{[(",
+			expectedFixed:      "{[()]}",
+			expectedInsertions: 3,
+		},
+		{
+			testName:           "Stray closing delimiter inside a deep group",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                "[((((]))))",
+			expectedFixed:      "[(((([]))))]",
+			expectedInsertions: 2,
+		},
+		{
+			testName:           "Template with unclosed delimiter and unterminated quote",
+			checker:            templateChecker(),
+			src:                "{{ print 'abc",
+			expectedFixed:      "{{ print 'abc'}}",
+			expectedInsertions: 2,
+		},
+		{
+			testName:           "Trailing escape is escaped before the closing delimiter",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                `(\`,
+			expectedFixed:      `(\\)`,
+			expectedInsertions: 2,
+		},
+		{
+			testName:           "Trailing escape inside an unterminated quote",
+			checker:            templateChecker(),
+			src:                `"abc\`,
+			expectedFixed:      `"abc\\"`,
+			expectedInsertions: 2,
+		},
+		{
+			testName:           "Escaped escape at the end is not dangling",
+			checker:            data_structures.NewBalanceChecker(),
+			src:                `(\\`,
+			expectedFixed:      `(\\)`,
+			expectedInsertions: 1,
+		},
+		{
+			testName:           "Balanced text with overlapping delimiters is unchanged",
+			checker:            templateChecker(),
+			src:                "{{{ x } }}",
+			expectedFixed:      "{{{ x } }}",
+			expectedInsertions: 0,
+		},
+		{
+			testName:           "Inserted delimiter is moved away from a neighbour it would run into",
+			checker:            templateChecker(),
+			src:                "{ x }}",
+			expectedFixed:      "{ {{x }}}",
+			expectedInsertions: 2,
+		},
+		{
+			testName:           "Inserted delimiter is separated from a neighbour it would run into",
+			checker:            templateChecker(),
+			src:                "{{ x }",
+			expectedFixed:      "{{{ x } }}",
+			expectedInsertions: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			fixed, insertions := test.checker.SuggestFix(test.src)
+
+			assert.Equal(t, test.expectedFixed, fixed)
+			assert.Len(t, insertions, test.expectedInsertions)
+			assert.True(t, test.checker.IsBalanced(fixed))
+		})
+	}
+}
+
+func TestBalanceChecker_SuggestFixInsertions(t *testing.T) {
+	fixed, insertions := data_structures.NewBalanceChecker().SuggestFix("x)\n(y")
+
+	assert.Equal(t, "(x)\n(y)", fixed)
+	assert.Equal(t, []data_structures.Insertion{
+		{Position: data_structures.Position{Offset: 0, Line: 1, Column: 1}, Text: "("},
+		{Position: data_structures.Position{Offset: 5, Line: 2, Column: 3}, Text: ")"},
+	}, insertions)
+}
+
+func TestBalanceChecker_SuggestFixIsBalanced(t *testing.T) {
+	alphabet := []byte("()[]{}a\"\\\n")
+	checker := data_structures.NewBalanceChecker()
+	checker.Quotes = []string{`"`}
+
+	rng := rand.New(rand.NewSource(42))
+
+	for range 20000 {
+		src := make([]byte, rng.Intn(12))
+		for i := range src {
+			src[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+
+		fixed, insertions := checker.SuggestFix(string(src))
+
+		if !assert.Truef(t, checker.IsBalanced(fixed), "SuggestFix(%q) = %q", src, fixed) {
+			return
+		}
+
+		inserted := 0
+		for _, ins := range insertions {
+			inserted += len(ins.Text)
+		}
+
+		assert.Equal(t, len(fixed)-len(src), inserted)
+	}
+}
+
+func TestBalanceChecker_SuggestFixOverlappingDelimiters(t *testing.T) {
+	alphabet := []byte("{{}} x'")
+	checker := templateChecker()
+
+	rng := rand.New(rand.NewSource(7))
+
+	for range 20000 {
+		src := make([]byte, rng.Intn(12))
+		for i := range src {
+			src[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+
+		fixed, _ := checker.SuggestFix(string(src))
+
+		if !assert.Truef(t, checker.IsBalanced(fixed), "SuggestFix(%q) = %q", src, fixed) {
+			return
+		}
+	}
+
+	long := strings.Repeat("{{{ }", data_structures.MaxExactFixTokens)
+	fixed, _ := checker.SuggestFix(long)
+	assert.True(t, checker.IsBalanced(fixed))
+}
+
+func TestBalanceChecker_SuggestFixGreedyForLargeInput(t *testing.T) {
+	src := strings.Repeat("(]", data_structures.MaxExactFixTokens)
+	checker := data_structures.NewBalanceChecker()
+
+	fixed, insertions := checker.SuggestFix(src)
+
+	assert.True(t, checker.IsBalanced(fixed))
+	assert.Equal(t, len(fixed)-len(src), len(insertions))
+}
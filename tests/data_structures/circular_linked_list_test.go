@@ -0,0 +1,353 @@
+package data_structures_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRotateCircularList struct {
+	testName      string
+	initialValues []int
+	rotateBy      int
+	expectedList  []int
+}
+
+type testRemoveEveryKthCircularList struct {
+	testName        string
+	initialValues   []int
+	step            int
+	remaining       int
+	expectedRemoved []int
+	expectedList    []int
+	expectedError   error
+}
+
+type testIterateFromCircularList struct {
+	testName      string
+	initialValues []int
+	startPos      int
+	expectedList  []int
+}
+
+type testJosephus struct {
+	testName         string
+	n                int
+	k                int
+	expectedOrder    []int
+	expectedSurvivor int
+	expectedError    error
+}
+
+func newCircularList(values []int) *linked_lists.CircularLinkedList[int] {
+	list := linked_lists.NewCircularLinkedList(func(a, b int) bool { return a == b })
+	for _, v := range values {
+		list.InsertAtEnd(v)
+	}
+
+	return list
+}
+
+func TestCircularLinkedList_Insert(t *testing.T) {
+	list := newCircularList([]int{2, 3})
+	list.InsertAtBeginning(1)
+	list.InsertAtEnd(5)
+
+	err := list.InsertAtPos(4, 3)
+	assert.NoError(t, err)
+
+	err = list.InsertAtPos(0, 10)
+	assert.ErrorIs(t, err, linked_lists.ErrInvalidPos)
+
+	result, err := list.LinkedListToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+	assert.Equal(t, 5, list.Len())
+	assert.Equal(t, list.HeadOfList(), list.Tail.Next)
+}
+
+func TestCircularLinkedList_Remove(t *testing.T) {
+	list := newCircularList([]int{1, 2, 3, 4, 5})
+
+	assert.NoError(t, list.RemoveFirstNode())
+	assert.NoError(t, list.RemoveNodeAtPosition(3))
+	assert.NoError(t, list.RemoveNodeAtPosition(1))
+	assert.ErrorIs(t, list.RemoveNodeAtPosition(2), linked_lists.ErrInvalidPos)
+
+	result, err := list.LinkedListToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4}, result)
+	assert.Equal(t, 4, list.Tail.Value)
+
+	assert.NoError(t, list.RemoveFirstNode())
+	assert.NoError(t, list.RemoveFirstNode())
+	assert.ErrorIs(t, list.RemoveFirstNode(), linked_lists.ErrListEmpty)
+	assert.Nil(t, list.Tail)
+
+	_, err = list.LinkedListToSlice()
+	assert.ErrorIs(t, err, linked_lists.ErrListEmpty)
+}
+
+func TestCircularLinkedList_FindNode(t *testing.T) {
+	list := newCircularList([]int{7, 8, 9})
+
+	index, err := list.FindNode(9)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	index, err = list.FindNode(10)
+	assert.ErrorIs(t, err, linked_lists.ErrValueNotFound)
+	assert.Equal(t, -1, index)
+}
+
+func TestCircularLinkedList_Rotate(t *testing.T) {
+	tests := []testRotateCircularList{
+		{
+			testName:      "Rotate empty circular linked list",
+			initialValues: []int{},
+			rotateBy:      3,
+			expectedList:  []int(nil),
+		},
+		{
+			testName:      "Rotate circular linked list forward",
+			initialValues: []int{1, 2, 3, 4, 5},
+			rotateBy:      2,
+			expectedList:  []int{3, 4, 5, 1, 2},
+		},
+		{
+			testName:      "Rotate circular linked list backward",
+			initialValues: []int{1, 2, 3, 4, 5},
+			rotateBy:      -1,
+			expectedList:  []int{5, 1, 2, 3, 4},
+		},
+		{
+			testName:      "Rotate circular linked list by more than its length",
+			initialValues: []int{1, 2, 3, 4, 5},
+			rotateBy:      12,
+			expectedList:  []int{3, 4, 5, 1, 2},
+		},
+		{
+			testName:      "Rotate circular linked list by its length",
+			initialValues: []int{1, 2, 3},
+			rotateBy:      3,
+			expectedList:  []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newCircularList(test.initialValues)
+			list.Rotate(test.rotateBy)
+
+			result, _ := list.LinkedListToSlice()
+			assert.Equal(t, test.expectedList, result)
+		})
+	}
+}
+
+func TestCircularLinkedList_RemoveEveryKth(t *testing.T) {
+	tests := []testRemoveEveryKthCircularList{
+		{
+			testName:        "Remove every 2nd element until one remains",
+			initialValues:   []int{1, 2, 3, 4, 5},
+			step:            2,
+			remaining:       1,
+			expectedRemoved: []int{2, 4, 1, 5},
+			expectedList:    []int{3},
+		},
+		{
+			testName:        "Remove every 3rd element until two remain",
+			initialValues:   []int{1, 2, 3, 4, 5, 6, 7},
+			step:            3,
+			remaining:       2,
+			expectedRemoved: []int{3, 6, 2, 7, 5},
+			expectedList:    []int{1, 4},
+		},
+		{
+			testName:        "Remove every element with step 1",
+			initialValues:   []int{1, 2, 3},
+			step:            1,
+			remaining:       0,
+			expectedRemoved: []int{1, 2, 3},
+			expectedList:    []int(nil),
+		},
+		{
+			testName:        "Remove with step larger than the list",
+			initialValues:   []int{1, 2, 3},
+			step:            5,
+			remaining:       1,
+			expectedRemoved: []int{2, 3},
+			expectedList:    []int{1},
+		},
+		{
+			testName:      "Remove with invalid step",
+			initialValues: []int{1, 2, 3},
+			step:          0,
+			remaining:     1,
+			expectedList:  []int{1, 2, 3},
+			expectedError: linked_lists.ErrInvalidStep,
+		},
+		{
+			testName:      "Remove with too many remaining elements",
+			initialValues: []int{1, 2, 3},
+			step:          2,
+			remaining:     4,
+			expectedList:  []int{1, 2, 3},
+			expectedError: linked_lists.ErrInvalidPos,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newCircularList(test.initialValues)
+
+			removed, err := list.RemoveEveryKth(test.step, test.remaining)
+			assert.ErrorIs(t, err, test.expectedError)
+
+			if test.expectedError == nil {
+				assert.Equal(t, test.expectedRemoved, removed)
+			}
+
+			result, _ := list.LinkedListToSlice()
+			assert.Equal(t, test.expectedList, result)
+		})
+	}
+}
+
+func TestCircularLinkedList_RemoveEveryKthContinuesCount(t *testing.T) {
+	list := newCircularList([]int{1, 2, 3, 4, 5, 6})
+
+	first, err := list.RemoveEveryKth(2, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4}, first)
+
+	second, err := list.RemoveEveryKth(2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{6, 3}, second)
+}
+
+func TestCircularLinkedList_AllFrom(t *testing.T) {
+	tests := []testIterateFromCircularList{
+		{
+			testName:      "Iterate from head",
+			initialValues: []int{1, 2, 3, 4},
+			startPos:      0,
+			expectedList:  []int{1, 2, 3, 4},
+		},
+		{
+			testName:      "Iterate from middle node",
+			initialValues: []int{1, 2, 3, 4},
+			startPos:      2,
+			expectedList:  []int{3, 4, 1, 2},
+		},
+		{
+			testName:      "Iterate from tail",
+			initialValues: []int{1, 2, 3, 4},
+			startPos:      3,
+			expectedList:  []int{4, 1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newCircularList(test.initialValues)
+
+			start, err := list.NodeAtPos(test.startPos)
+			assert.NoError(t, err)
+
+			var result []int
+			list.AllFrom(start)(func(value int) bool {
+				result = append(result, value)
+				return true
+			})
+
+			assert.Equal(t, test.expectedList, result)
+		})
+	}
+}
+
+func TestCircularLinkedList_AllStopsEarly(t *testing.T) {
+	list := newCircularList([]int{1, 2, 3, 4})
+
+	var result []int
+	list.All()(func(value int) bool {
+		result = append(result, value)
+		return value < 2
+	})
+
+	assert.Equal(t, []int{1, 2}, result)
+}
+
+func TestJosephus(t *testing.T) {
+	tests := []testJosephus{
+		{
+			testName:         "Josephus with a single person",
+			n:                1,
+			k:                3,
+			expectedOrder:    []int{},
+			expectedSurvivor: 0,
+		},
+		{
+			testName:         "Josephus with 7 people and step 3",
+			n:                7,
+			k:                3,
+			expectedOrder:    []int{2, 5, 1, 6, 4, 0},
+			expectedSurvivor: 3,
+		},
+		{
+			testName:         "Josephus with 10 people and step 2",
+			n:                10,
+			k:                2,
+			expectedOrder:    []int{1, 3, 5, 7, 9, 2, 6, 0, 8},
+			expectedSurvivor: 4,
+		},
+		{
+			testName:         "Josephus with step 1",
+			n:                4,
+			k:                1,
+			expectedOrder:    []int{0, 1, 2},
+			expectedSurvivor: 3,
+		},
+		{
+			testName:         "Josephus with no people",
+			n:                0,
+			k:                2,
+			expectedSurvivor: -1,
+			expectedError:    linked_lists.ErrInvalidCount,
+		},
+		{
+			testName:         "Josephus with invalid step",
+			n:                5,
+			k:                0,
+			expectedSurvivor: -1,
+			expectedError:    linked_lists.ErrInvalidStep,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			order, survivor, err := linked_lists.Josephus(test.n, test.k)
+			assert.ErrorIs(t, err, test.expectedError)
+			assert.Equal(t, test.expectedOrder, order)
+			assert.Equal(t, test.expectedSurvivor, survivor)
+
+			arithmetic, err := linked_lists.JosephusSurvivor(test.n, test.k)
+			assert.ErrorIs(t, err, test.expectedError)
+			assert.Equal(t, test.expectedSurvivor, arithmetic)
+		})
+	}
+}
+
+func TestJosephus_CrossCheck(t *testing.T) {
+	for n := 1; n <= 40; n++ {
+		for k := 1; k <= 12; k++ {
+			_, survivor, err := linked_lists.Josephus(n, k)
+			assert.NoError(t, err)
+
+			expected, err := linked_lists.JosephusSurvivor(n, k)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, survivor, "n=%d k=%d", n, k)
+		}
+	}
+}
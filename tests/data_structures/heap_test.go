@@ -1709,3 +1709,18 @@ func TestMinHeapPopping(t *testing.T) {
 		})
 	}
 }
+
+func TestHeapBuildAndSiftDownOverIndices(t *testing.T) {
+	data := []int{5, 9, 1, 7, 3, 8, 2}
+	less := func(a, b int) bool { return data[a] < data[b] }
+	swap := func(a, b int) { data[a], data[b] = data[b], data[a] }
+
+	heap.Build(len(data), less, swap)
+	assert.True(t, (&heap.Heap[int]{Data: data, Comparator: func(a, b int) bool { return a < b }}).IsHeap())
+	assert.Equal(t, 1, data[0])
+
+	data[0] = 10
+	heap.SiftDown(0, len(data), less, swap)
+	assert.True(t, (&heap.Heap[int]{Data: data, Comparator: func(a, b int) bool { return a < b }}).IsHeap())
+	assert.Equal(t, 2, data[0])
+}
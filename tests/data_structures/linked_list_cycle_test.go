@@ -0,0 +1,267 @@
+package data_structures_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCycleList struct {
+	testName            string
+	initialValues       []int
+	cycleTo             int
+	expectedHasCycle    bool
+	expectedCycleStart  int
+	expectedCycleLength int
+	expectedAfterBreak  []int
+}
+
+type testMiddleList struct {
+	testName       string
+	initialValues  []int
+	expectedMiddle int
+	expectedError  error
+}
+
+type testKthFromEndList struct {
+	testName      string
+	initialValues []int
+	k             int
+	expectedValue int
+	expectedError error
+}
+
+// newSinglyListWithCycle builds a list and links its last node back to the node at cycleTo.
+//
+// A negative cycleTo leaves the list linear.
+func newSinglyListWithCycle(values []int, cycleTo int) *linked_lists.SinglyLinkedList[int] {
+	list := linked_lists.NewSinglyLinkedList(func(a, b int) bool { return a == b })
+	for _, v := range values {
+		list.InsertAtEnd(v)
+	}
+
+	if cycleTo < 0 || list.Head == nil {
+		return list
+	}
+
+	var target, last *linked_lists.NodeSinglyLinked[int]
+	index := 0
+	for current := list.Head; current != nil; current = current.Next {
+		if index == cycleTo {
+			target = current
+		}
+
+		last = current
+		index++
+	}
+
+	last.Next = target
+
+	return list
+}
+
+func TestSinglyLinkedList_Cycle(t *testing.T) {
+	tests := []testCycleList{
+		{
+			testName:           "Empty list has no cycle",
+			initialValues:      []int{},
+			cycleTo:            -1,
+			expectedHasCycle:   false,
+			expectedAfterBreak: []int(nil),
+		},
+		{
+			testName:           "Linear list has no cycle",
+			initialValues:      []int{1, 2, 3, 4},
+			cycleTo:            -1,
+			expectedHasCycle:   false,
+			expectedAfterBreak: []int{1, 2, 3, 4},
+		},
+		{
+			testName:            "Single node pointing to itself",
+			initialValues:       []int{1},
+			cycleTo:             0,
+			expectedHasCycle:    true,
+			expectedCycleStart:  1,
+			expectedCycleLength: 1,
+			expectedAfterBreak:  []int{1},
+		},
+		{
+			testName:            "Whole list is a cycle",
+			initialValues:       []int{1, 2, 3, 4, 5},
+			cycleTo:             0,
+			expectedHasCycle:    true,
+			expectedCycleStart:  1,
+			expectedCycleLength: 5,
+			expectedAfterBreak:  []int{1, 2, 3, 4, 5},
+		},
+		{
+			testName:            "Cycle starting in the middle",
+			initialValues:       []int{1, 2, 3, 4, 5, 6, 7},
+			cycleTo:             3,
+			expectedHasCycle:    true,
+			expectedCycleStart:  4,
+			expectedCycleLength: 4,
+			expectedAfterBreak:  []int{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			testName:            "Last node pointing to itself",
+			initialValues:       []int{1, 2, 3},
+			cycleTo:             2,
+			expectedHasCycle:    true,
+			expectedCycleStart:  3,
+			expectedCycleLength: 1,
+			expectedAfterBreak:  []int{1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newSinglyListWithCycle(test.initialValues, test.cycleTo)
+
+			assert.Equal(t, test.expectedHasCycle, list.HasCycle())
+			assert.Equal(t, test.expectedCycleLength, list.CycleLength())
+
+			start, err := list.CycleStart()
+			if test.expectedHasCycle {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedCycleStart, start.Value)
+				assert.ErrorIs(t, list.Validate(), linked_lists.ErrCycleDetected)
+				assert.NoError(t, list.BreakCycle())
+			} else {
+				assert.ErrorIs(t, err, linked_lists.ErrNoCycle)
+				assert.ErrorIs(t, list.BreakCycle(), linked_lists.ErrNoCycle)
+			}
+
+			assert.False(t, list.HasCycle())
+			assert.NoError(t, list.Validate())
+
+			result, _ := list.LinkedListToSlice()
+			assert.Equal(t, test.expectedAfterBreak, result)
+		})
+	}
+}
+
+func TestSinglyLinkedList_Middle(t *testing.T) {
+	tests := []testMiddleList{
+		{
+			testName:      "Middle of empty list",
+			initialValues: []int{},
+			expectedError: linked_lists.ErrListEmpty,
+		},
+		{
+			testName:       "Middle of single element list",
+			initialValues:  []int{1},
+			expectedMiddle: 1,
+		},
+		{
+			testName:       "Middle of odd length list",
+			initialValues:  []int{1, 2, 3, 4, 5},
+			expectedMiddle: 3,
+		},
+		{
+			testName:       "Middle of even length list",
+			initialValues:  []int{1, 2, 3, 4, 5, 6},
+			expectedMiddle: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newSinglyListWithCycle(test.initialValues, -1)
+
+			middle, err := list.Middle()
+			assert.ErrorIs(t, err, test.expectedError)
+
+			if test.expectedError == nil {
+				assert.Equal(t, test.expectedMiddle, middle.Value)
+			}
+		})
+	}
+
+	_, err := newSinglyListWithCycle([]int{1, 2, 3}, 1).Middle()
+	assert.ErrorIs(t, err, linked_lists.ErrCycleDetected)
+}
+
+func TestSinglyLinkedList_KthFromEnd(t *testing.T) {
+	tests := []testKthFromEndList{
+		{
+			testName:      "Last node",
+			initialValues: []int{1, 2, 3, 4, 5},
+			k:             1,
+			expectedValue: 5,
+		},
+		{
+			testName:      "Second node from end",
+			initialValues: []int{1, 2, 3, 4, 5},
+			k:             2,
+			expectedValue: 4,
+		},
+		{
+			testName:      "First node as k equal to length",
+			initialValues: []int{1, 2, 3, 4, 5},
+			k:             5,
+			expectedValue: 1,
+		},
+		{
+			testName:      "K greater than length",
+			initialValues: []int{1, 2, 3},
+			k:             4,
+			expectedError: linked_lists.ErrPosOutOfRange,
+		},
+		{
+			testName:      "Non-positive k",
+			initialValues: []int{1, 2, 3},
+			k:             0,
+			expectedError: linked_lists.ErrInvalidPos,
+		},
+		{
+			testName:      "Empty list",
+			initialValues: []int{},
+			k:             1,
+			expectedError: linked_lists.ErrPosOutOfRange,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newSinglyListWithCycle(test.initialValues, -1)
+
+			node, err := list.KthFromEnd(test.k)
+			assert.ErrorIs(t, err, test.expectedError)
+
+			if test.expectedError == nil {
+				assert.Equal(t, test.expectedValue, node.Value)
+			}
+		})
+	}
+
+	values := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}
+	for cycleTo := range values {
+		for _, k := range []int{1, 2, len(values), 3 * len(values)} {
+			_, err := newSinglyListWithCycle(values, cycleTo).KthFromEnd(k)
+			assert.ErrorIs(t, err, linked_lists.ErrCycleDetected, "cycle to %d, k = %d", cycleTo, k)
+		}
+	}
+}
+
+func TestSinglyLinkedList_Validate(t *testing.T) {
+	list := newSinglyListWithCycle([]int{1, 2, 3}, -1)
+	assert.NoError(t, list.Validate())
+
+	list.Head.Next.Next.Next = &linked_lists.NodeSinglyLinked[int]{Value: 4}
+	assert.ErrorIs(t, list.Validate(), linked_lists.ErrLengthMismatch)
+	assert.EqualError(t, list.Validate(), "list length does not match node count: counted 4 nodes, LenOfList is 3")
+
+	list.LenOfList = 4
+	assert.NoError(t, list.Validate())
+}
+
+func TestSinglyLinkedList_BreakCycleFixesLength(t *testing.T) {
+	list := newSinglyListWithCycle([]int{1, 2, 3, 4}, 1)
+	list.LenOfList = 100
+
+	assert.NoError(t, list.BreakCycle())
+	assert.Equal(t, 4, list.Len())
+	assert.NoError(t, list.Validate())
+}
@@ -8738,3 +8738,30 @@ func TestSinglyLinkedList_LenOfList(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedLists_Iterators(t *testing.T) {
+	sll := linked_lists.NewSinglyLinkedList[int](nil)
+	dll := linked_lists.NewDoublyLinkedList[int](nil)
+
+	for _, elem := range []int{1, 2, 3, 4} {
+		sll.InsertAtEnd(elem)
+		dll.InsertAtEnd(elem)
+	}
+
+	collect := func(seq func(yield func(int) bool), limit int) []int {
+		var values []int
+		seq(func(value int) bool {
+			values = append(values, value)
+			return len(values) < limit
+		})
+
+		return values
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, collect(sll.All(), 10))
+	assert.Equal(t, []int{1, 2}, collect(sll.All(), 2))
+	assert.Equal(t, []int{1, 2, 3, 4}, collect(dll.All(), 10))
+	assert.Equal(t, []int{4, 3, 2, 1}, collect(dll.Backward(), 10))
+	assert.Equal(t, []int{4, 3, 2}, collect(dll.Backward(), 3))
+	assert.Nil(t, collect(linked_lists.NewSinglyLinkedList[int](nil).All(), 10))
+}
@@ -0,0 +1,287 @@
+package data_structures_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNeighbourIndices struct {
+	testName            string
+	data                []int
+	expectedNextGreater []int
+	expectedNextSmaller []int
+	expectedPrevGreater []int
+	expectedPrevSmaller []int
+}
+
+type testStockSpan struct {
+	testName      string
+	prices        interface{}
+	expectedSpans []int
+}
+
+type testHistogram struct {
+	testName     string
+	heights      interface{}
+	expectedArea interface{}
+}
+
+type testMaximalRectangle struct {
+	testName     string
+	matrix       []string
+	expectedArea int
+}
+
+type testTrapRainWater struct {
+	testName      string
+	heights       interface{}
+	expectedWater interface{}
+}
+
+func TestMonotonicStack_NeighbourIndices(t *testing.T) {
+	tests := []testNeighbourIndices{
+		{
+			testName:            "Empty slice",
+			data:                []int{},
+			expectedNextGreater: []int{},
+			expectedNextSmaller: []int{},
+			expectedPrevGreater: []int{},
+			expectedPrevSmaller: []int{},
+		},
+		{
+			testName:            "Increasing values",
+			data:                []int{1, 2, 3, 4},
+			expectedNextGreater: []int{1, 2, 3, -1},
+			expectedNextSmaller: []int{-1, -1, -1, -1},
+			expectedPrevGreater: []int{-1, -1, -1, -1},
+			expectedPrevSmaller: []int{-1, 0, 1, 2},
+		},
+		{
+			testName:            "Decreasing values",
+			data:                []int{4, 3, 2, 1},
+			expectedNextGreater: []int{-1, -1, -1, -1},
+			expectedNextSmaller: []int{1, 2, 3, -1},
+			expectedPrevGreater: []int{-1, 0, 1, 2},
+			expectedPrevSmaller: []int{-1, -1, -1, -1},
+		},
+		{
+			testName:            "Mixed values",
+			data:                []int{2, 1, 5, 3, 4},
+			expectedNextGreater: []int{2, 2, -1, 4, -1},
+			expectedNextSmaller: []int{1, -1, 3, -1, -1},
+			expectedPrevGreater: []int{-1, 0, -1, 2, 2},
+			expectedPrevSmaller: []int{-1, -1, 1, 1, 3},
+		},
+		{
+			testName:            "Equal values are not strictly greater or smaller",
+			data:                []int{3, 3, 3},
+			expectedNextGreater: []int{-1, -1, -1},
+			expectedNextSmaller: []int{-1, -1, -1},
+			expectedPrevGreater: []int{-1, -1, -1},
+			expectedPrevSmaller: []int{-1, -1, -1},
+		},
+	}
+
+	less := func(a, b int) bool { return a < b }
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expectedNextGreater, data_structures.NextGreater(test.data, less))
+			assert.Equal(t, test.expectedNextSmaller, data_structures.NextSmaller(test.data, less))
+			assert.Equal(t, test.expectedPrevGreater, data_structures.PrevGreater(test.data, less))
+			assert.Equal(t, test.expectedPrevSmaller, data_structures.PrevSmaller(test.data, less))
+		})
+	}
+}
+
+func TestMonotonicStack_NextGreaterStrings(t *testing.T) {
+	data := []string{"pear", "apple", "zebra", "kiwi"}
+	less := func(a, b string) bool { return a < b }
+
+	assert.Equal(t, []int{2, 2, -1, -1}, data_structures.NextGreater(data, less))
+}
+
+func TestMonotonicStack_StockSpan(t *testing.T) {
+	tests := []testStockSpan{
+		{
+			testName:      "Empty prices",
+			prices:        []int{},
+			expectedSpans: []int{},
+		},
+		{
+			testName:      "Classic int example",
+			prices:        []int{100, 80, 60, 70, 60, 75, 85},
+			expectedSpans: []int{1, 1, 1, 2, 1, 4, 6},
+		},
+		{
+			testName:      "Equal int prices extend the span",
+			prices:        []int{10, 10, 10},
+			expectedSpans: []int{1, 2, 3},
+		},
+		{
+			testName:      "Float64 prices",
+			prices:        []float64{31.5, 27.25, 27.25, 40.0, 12.75},
+			expectedSpans: []int{1, 1, 2, 4, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			switch prices := test.prices.(type) {
+			case []int:
+				spans := data_structures.StockSpan(prices, func(a, b int) bool { return a < b })
+				assert.Equal(t, test.expectedSpans, spans)
+			case []float64:
+				spans := data_structures.StockSpan(prices, func(a, b float64) bool { return a < b })
+				assert.Equal(t, test.expectedSpans, spans)
+			}
+		})
+	}
+}
+
+func TestMonotonicStack_LargestRectangle(t *testing.T) {
+	tests := []testHistogram{
+		{
+			testName:     "Empty int histogram",
+			heights:      []int{},
+			expectedArea: 0,
+		},
+		{
+			testName:     "Classic int histogram",
+			heights:      []int{2, 1, 5, 6, 2, 3},
+			expectedArea: 10,
+		},
+		{
+			testName:     "Flat int histogram",
+			heights:      []int{3, 3, 3, 3},
+			expectedArea: 12,
+		},
+		{
+			testName:     "Int histogram with zero bars",
+			heights:      []int{0, 4, 0, 2, 2, 2, 0},
+			expectedArea: 6,
+		},
+		{
+			testName:     "Increasing int64 histogram",
+			heights:      []int64{1, 2, 3, 4, 5},
+			expectedArea: int64(9),
+		},
+		{
+			testName:     "Float64 histogram",
+			heights:      []float64{1.5, 2.5, 2.0},
+			expectedArea: 4.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			switch heights := test.heights.(type) {
+			case []int:
+				assert.Equal(t, test.expectedArea, data_structures.LargestRectangle(heights))
+			case []int64:
+				assert.Equal(t, test.expectedArea, data_structures.LargestRectangle(heights))
+			case []float64:
+				assert.InDelta(t, test.expectedArea, data_structures.LargestRectangle(heights), 1e-9)
+			}
+		})
+	}
+}
+
+func TestMonotonicStack_MaximalRectangle(t *testing.T) {
+	tests := []testMaximalRectangle{
+		{
+			testName:     "Empty matrix",
+			matrix:       []string{},
+			expectedArea: 0,
+		},
+		{
+			testName:     "All zeros",
+			matrix:       []string{"000", "000"},
+			expectedArea: 0,
+		},
+		{
+			testName: "Classic matrix",
+			matrix: []string{
+				"10100",
+				"10111",
+				"11111",
+				"10010",
+			},
+			expectedArea: 6,
+		},
+		{
+			testName:     "All ones",
+			matrix:       []string{"111", "111"},
+			expectedArea: 6,
+		},
+		{
+			testName:     "Ragged rows",
+			matrix:       []string{"11", "1111", "1111"},
+			expectedArea: 8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			matrix := make([][]bool, len(test.matrix))
+			for i, row := range test.matrix {
+				matrix[i] = make([]bool, len(row))
+				for j, cell := range row {
+					matrix[i][j] = cell == '1'
+				}
+			}
+
+			assert.Equal(t, test.expectedArea, data_structures.MaximalRectangle(matrix))
+		})
+	}
+}
+
+func TestMonotonicStack_TrapRainWater(t *testing.T) {
+	tests := []testTrapRainWater{
+		{
+			testName:      "Empty int elevation map",
+			heights:       []int{},
+			expectedWater: 0,
+		},
+		{
+			testName:      "Classic int elevation map",
+			heights:       []int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1},
+			expectedWater: 6,
+		},
+		{
+			testName:      "Second classic int elevation map",
+			heights:       []int{4, 2, 0, 3, 2, 5},
+			expectedWater: 9,
+		},
+		{
+			testName:      "Monotonic int elevation map traps nothing",
+			heights:       []int{1, 2, 3, 4},
+			expectedWater: 0,
+		},
+		{
+			testName:      "Uint elevation map",
+			heights:       []uint{3, 0, 3},
+			expectedWater: uint(3),
+		},
+		{
+			testName:      "Float64 elevation map",
+			heights:       []float64{2.5, 0.5, 1.0, 3.0},
+			expectedWater: 3.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			switch heights := test.heights.(type) {
+			case []int:
+				assert.Equal(t, test.expectedWater, data_structures.TrapRainWater(heights))
+			case []uint:
+				assert.Equal(t, test.expectedWater, data_structures.TrapRainWater(heights))
+			case []float64:
+				assert.InDelta(t, test.expectedWater, data_structures.TrapRainWater(heights), 1e-9)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package data_structures_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPersistentListReverse struct {
+	testName      string
+	initialValues []int
+	expectedList  []int
+}
+
+type testPersistentListAppend struct {
+	testName     string
+	first        []int
+	second       []int
+	expectedList []int
+}
+
+type testPersistentListFilter struct {
+	testName      string
+	initialValues []int
+	keep          func(int) bool
+	expectedList  []int
+}
+
+func TestPersistentList_ConsHeadTail(t *testing.T) {
+	var empty linked_lists.List[int]
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, 0, empty.Len())
+
+	_, err := empty.Head()
+	assert.ErrorIs(t, err, linked_lists.ErrListEmpty)
+
+	_, err = empty.Tail()
+	assert.ErrorIs(t, err, linked_lists.ErrListEmpty)
+
+	base := linked_lists.NewList(2, 3)
+	extended := base.Cons(1)
+
+	head, err := extended.Head()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, head)
+	assert.Equal(t, 3, extended.Len())
+
+	tail, err := extended.Tail()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, tail.ToSlice())
+	assert.Equal(t, []int{2, 3}, base.ToSlice())
+}
+
+func TestPersistentList_VersionsAreIndependent(t *testing.T) {
+	base := linked_lists.NewList(1, 2, 3)
+
+	left := base.Cons(0)
+	right := base.Cons(10)
+	reversed := base.Reverse()
+	appended := base.Append(linked_lists.NewList(4))
+	filtered := base.Filter(func(v int) bool { return v != 2 })
+
+	assert.Equal(t, []int{1, 2, 3}, base.ToSlice())
+	assert.Equal(t, []int{0, 1, 2, 3}, left.ToSlice())
+	assert.Equal(t, []int{10, 1, 2, 3}, right.ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, reversed.ToSlice())
+	assert.Equal(t, []int{1, 2, 3, 4}, appended.ToSlice())
+	assert.Equal(t, []int{1, 3}, filtered.ToSlice())
+}
+
+func TestPersistentList_Reverse(t *testing.T) {
+	tests := []testPersistentListReverse{
+		{
+			testName:      "Reverse empty persistent list",
+			initialValues: []int{},
+			expectedList:  []int{},
+		},
+		{
+			testName:      "Reverse persistent list with one element",
+			initialValues: []int{42},
+			expectedList:  []int{42},
+		},
+		{
+			testName:      "Reverse non-empty persistent list",
+			initialValues: []int{100, 42, 72, 5, 36},
+			expectedList:  []int{36, 5, 72, 42, 100},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := linked_lists.NewList(test.initialValues...)
+			reversed := list.Reverse()
+
+			assert.Equal(t, test.expectedList, reversed.ToSlice())
+			assert.Equal(t, len(test.expectedList), reversed.Len())
+		})
+	}
+}
+
+func TestPersistentList_Append(t *testing.T) {
+	tests := []testPersistentListAppend{
+		{
+			testName:     "Append two empty persistent lists",
+			first:        []int{},
+			second:       []int{},
+			expectedList: []int{},
+		},
+		{
+			testName:     "Append to empty persistent list",
+			first:        []int{},
+			second:       []int{1, 2},
+			expectedList: []int{1, 2},
+		},
+		{
+			testName:     "Append empty persistent list",
+			first:        []int{1, 2},
+			second:       []int{},
+			expectedList: []int{1, 2},
+		},
+		{
+			testName:     "Append two non-empty persistent lists",
+			first:        []int{1, 2},
+			second:       []int{3, 4, 5},
+			expectedList: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			first := linked_lists.NewList(test.first...)
+			second := linked_lists.NewList(test.second...)
+			result := first.Append(second)
+
+			assert.Equal(t, test.expectedList, result.ToSlice())
+			assert.Equal(t, len(test.expectedList), result.Len())
+			assert.Equal(t, test.first, first.ToSlice())
+			assert.Equal(t, test.second, second.ToSlice())
+		})
+	}
+}
+
+func TestPersistentList_Filter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []testPersistentListFilter{
+		{
+			testName:      "Filter empty persistent list",
+			initialValues: []int{},
+			keep:          isEven,
+			expectedList:  []int{},
+		},
+		{
+			testName:      "Filter persistent list keeping everything",
+			initialValues: []int{2, 4, 6},
+			keep:          isEven,
+			expectedList:  []int{2, 4, 6},
+		},
+		{
+			testName:      "Filter persistent list dropping everything",
+			initialValues: []int{1, 3, 5},
+			keep:          isEven,
+			expectedList:  []int{},
+		},
+		{
+			testName:      "Filter persistent list with mixed values",
+			initialValues: []int{1, 2, 3, 4, 6, 8},
+			keep:          isEven,
+			expectedList:  []int{2, 4, 6, 8},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := linked_lists.NewList(test.initialValues...)
+			result := list.Filter(test.keep)
+
+			assert.Equal(t, test.expectedList, result.ToSlice())
+			assert.Equal(t, len(test.expectedList), result.Len())
+		})
+	}
+}
+
+func TestPersistentList_FilterCallsKeepOncePerElement(t *testing.T) {
+	list := linked_lists.NewList(1, 2, 3, 4, 5, 6, 8, 10)
+
+	calls := make(map[int]int)
+	result := list.Filter(func(v int) bool {
+		calls[v]++
+		return v%2 == 0
+	})
+
+	assert.Equal(t, []int{2, 4, 6, 8, 10}, result.ToSlice())
+	assert.Equal(t, 5, result.Len())
+
+	for _, v := range list.ToSlice() {
+		assert.Equal(t, 1, calls[v], "keep(%d)", v)
+	}
+}
+
+func TestPersistentList_MapAndFold(t *testing.T) {
+	list := linked_lists.NewList(1, 2, 3, 4)
+
+	mapped := linked_lists.Map(list, func(v int) string { return strconv.Itoa(v * v) })
+	assert.Equal(t, []string{"1", "4", "9", "16"}, mapped.ToSlice())
+
+	sum := linked_lists.Fold(list, 0, func(acc, v int) int { return acc + v })
+	assert.Equal(t, 10, sum)
+
+	joined := linked_lists.Fold(mapped, "", func(acc, v string) string { return acc + v + ";" })
+	assert.Equal(t, "1;4;9;16;", joined)
+
+	var empty linked_lists.List[int]
+	assert.Equal(t, 0, linked_lists.Map(empty, func(v int) int { return v }).Len())
+	assert.Equal(t, 7, linked_lists.Fold(empty, 7, func(acc, v int) int { return acc + v }))
+}
+
+func TestPersistentList_All(t *testing.T) {
+	list := linked_lists.NewList(5, 6, 7)
+
+	var result []int
+	list.All()(func(v int) bool {
+		result = append(result, v)
+		return v < 6
+	})
+
+	assert.Equal(t, []int{5, 6}, result)
+}
+
+func TestPersistentList_ConcurrentReaders(t *testing.T) {
+	shared := linked_lists.NewList(1, 2, 3, 4, 5)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			version := shared.Cons(i).Filter(func(v int) bool { return v%2 == 1 })
+			assert.Equal(t, i%2+3, version.Len())
+			assert.Equal(t, 15, linked_lists.Fold(shared, 0, func(acc, v int) int { return acc + v }))
+		}(i)
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, shared.ToSlice())
+}
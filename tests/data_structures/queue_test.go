@@ -1 +1,134 @@
 package data_structures_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/queues"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPushPopQueue struct {
+	testName       string
+	pushValues     []int
+	popCount       int
+	expectedPopped []int
+	expectedLen    int
+	expectedError  error
+}
+
+func TestQueue_PushPop(t *testing.T) {
+	tests := []testPushPopQueue{
+		{
+			testName:       "Pop from empty queue",
+			pushValues:     []int{},
+			popCount:       1,
+			expectedPopped: []int{},
+			expectedLen:    0,
+			expectedError:  queues.ErrQueueEmpty,
+		},
+		{
+			testName:       "Push a single element counts it",
+			pushValues:     []int{42},
+			popCount:       0,
+			expectedPopped: []int{},
+			expectedLen:    1,
+		},
+		{
+			testName:       "Push and pop a single element",
+			pushValues:     []int{42},
+			popCount:       1,
+			expectedPopped: []int{42},
+			expectedLen:    0,
+		},
+		{
+			testName:       "Pop returns elements in FIFO order",
+			pushValues:     []int{1, 2, 3, 4, 5},
+			popCount:       3,
+			expectedPopped: []int{1, 2, 3},
+			expectedLen:    2,
+		},
+		{
+			testName:       "Pop every element and one more",
+			pushValues:     []int{1, 2},
+			popCount:       3,
+			expectedPopped: []int{1, 2},
+			expectedLen:    0,
+			expectedError:  queues.ErrQueueEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			queue := queues.NewQueue[int](nil)
+
+			for i, value := range test.pushValues {
+				queue.Push(value)
+				assert.Equal(t, i+1, queue.Len())
+			}
+
+			popped := []int{}
+			var err error
+
+			for i := 0; i < test.popCount; i++ {
+				var value int
+				if value, err = queue.Pop(); err != nil {
+					break
+				}
+
+				popped = append(popped, value)
+			}
+
+			assert.ErrorIs(t, err, test.expectedError)
+			assert.Equal(t, test.expectedPopped, popped)
+			assert.Equal(t, test.expectedLen, queue.Len())
+		})
+	}
+}
+
+func TestQueue_PushAfterEmptied(t *testing.T) {
+	queue := queues.NewQueue[int](nil)
+
+	queue.Push(1)
+	_, err := queue.Pop()
+	assert.NoError(t, err)
+
+	queue.Push(2)
+	queue.Push(3)
+	assert.Equal(t, 2, queue.Len())
+
+	result, err := queue.QueueToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, result)
+}
+
+func TestDeque_Iterators(t *testing.T) {
+	deque := queues.NewDeque[int](nil)
+
+	deque.PushAtEnd(2)
+	deque.PushAtEnd(3)
+	deque.PushAtBegin(1)
+	deque.PushAtEnd(4)
+
+	collect := func(seq func(yield func(int) bool), limit int) []int {
+		var values []int
+		seq(func(value int) bool {
+			values = append(values, value)
+			return len(values) < limit
+		})
+
+		return values
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, collect(deque.All(), 10))
+	assert.Equal(t, []int{4, 3, 2, 1}, collect(deque.Backward(), 10))
+	assert.Equal(t, []int{4, 3}, collect(deque.Backward(), 2))
+
+	deque.Reverse()
+	assert.Equal(t, []int{1, 2, 3, 4}, collect(deque.Backward(), 10))
+
+	_, err := deque.PopEnd()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, collect(deque.Backward(), 10))
+
+	assert.Nil(t, collect(queues.NewDeque[int](nil).Backward(), 10))
+}
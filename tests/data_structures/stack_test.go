@@ -1 +1,305 @@
 package data_structures_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPushPopStack struct {
+	testName       string
+	pushValues     []int
+	popCount       int
+	expectedPopped []int
+	expectedStack  []int
+	expectedError  error
+}
+
+type testMinStack struct {
+	testName    string
+	operations  []string
+	values      []int
+	expectedMin []int
+}
+
+type testUndoStack struct {
+	testName        string
+	limit           int
+	operations      []string
+	values          []string
+	expectedResults []string
+	expectedHistory []string
+	expectedRedoLen int
+}
+
+func TestStack_PushPop(t *testing.T) {
+	tests := []testPushPopStack{
+		{
+			testName:       "Pop from empty stack",
+			pushValues:     []int{},
+			popCount:       1,
+			expectedPopped: []int{},
+			expectedStack:  []int{},
+			expectedError:  data_structures.ErrStackEmpty,
+		},
+		{
+			testName:       "Push and pop a single element",
+			pushValues:     []int{42},
+			popCount:       1,
+			expectedPopped: []int{42},
+			expectedStack:  []int{},
+		},
+		{
+			testName:       "Pop returns elements in LIFO order",
+			pushValues:     []int{1, 2, 3, 4, 5},
+			popCount:       3,
+			expectedPopped: []int{5, 4, 3},
+			expectedStack:  []int{1, 2},
+		},
+		{
+			testName:       "Pop more elements than pushed",
+			pushValues:     []int{1, 2},
+			popCount:       3,
+			expectedPopped: []int{2, 1},
+			expectedStack:  []int{},
+			expectedError:  data_structures.ErrStackEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			stack := data_structures.NewStack[int](len(test.pushValues))
+			for _, v := range test.pushValues {
+				stack.Push(v)
+			}
+
+			popped := []int{}
+			var err error
+			for i := 0; i < test.popCount; i++ {
+				var value int
+				value, err = stack.Pop()
+				if err != nil {
+					break
+				}
+
+				popped = append(popped, value)
+			}
+
+			assert.ErrorIs(t, err, test.expectedError)
+			assert.Equal(t, test.expectedPopped, popped)
+			assert.Equal(t, test.expectedStack, stack.ToSlice())
+			assert.Equal(t, len(test.expectedStack), stack.Len())
+		})
+	}
+}
+
+func TestStack_PeekAndClear(t *testing.T) {
+	stack := data_structures.NewStack[string](0)
+	assert.True(t, stack.IsEmpty())
+
+	_, err := stack.Peek()
+	assert.ErrorIs(t, err, data_structures.ErrStackEmpty)
+
+	stack.Push("a")
+	stack.Push("b")
+
+	top, err := stack.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, "b", top)
+	assert.Equal(t, 2, stack.Len())
+
+	snapshot := stack.ToSlice()
+	stack.Clear()
+
+	assert.True(t, stack.IsEmpty())
+	assert.Equal(t, []string{"a", "b"}, snapshot)
+
+	_, err = stack.Pop()
+	assert.ErrorIs(t, err, data_structures.ErrStackEmpty)
+}
+
+func TestMinStack(t *testing.T) {
+	tests := []testMinStack{
+		{
+			testName:    "Min follows decreasing pushes",
+			operations:  []string{"push", "push", "push"},
+			values:      []int{5, 3, 1},
+			expectedMin: []int{5, 3, 1},
+		},
+		{
+			testName:    "Min restored after popping the minimum",
+			operations:  []string{"push", "push", "push", "pop", "pop"},
+			values:      []int{2, 7, 1, 0, 0},
+			expectedMin: []int{2, 2, 1, 2, 2},
+		},
+		{
+			testName:    "Min with duplicated minimum",
+			operations:  []string{"push", "push", "push", "pop"},
+			values:      []int{3, 1, 1, 0},
+			expectedMin: []int{3, 1, 1, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			stack := data_structures.NewMinStack(func(a, b int) bool { return a < b })
+
+			for i, op := range test.operations {
+				switch op {
+				case "push":
+					stack.Push(test.values[i])
+				case "pop":
+					_, err := stack.Pop()
+					assert.NoError(t, err)
+				}
+
+				minimum, err := stack.Min()
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedMin[i], minimum)
+			}
+		})
+	}
+}
+
+func TestMinStack_MaxComparatorAndEmpty(t *testing.T) {
+	stack := data_structures.NewMinStack(func(a, b float64) bool { return a > b })
+
+	_, err := stack.Min()
+	assert.ErrorIs(t, err, data_structures.ErrStackEmpty)
+
+	_, err = stack.Pop()
+	assert.ErrorIs(t, err, data_structures.ErrStackEmpty)
+
+	for _, v := range []float64{1.5, 9.25, 3.0} {
+		stack.Push(v)
+	}
+
+	maximum, err := stack.Min()
+	assert.NoError(t, err)
+	assert.Equal(t, 9.25, maximum)
+
+	top, err := stack.Peek()
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, top)
+	assert.Equal(t, []float64{1.5, 9.25, 3.0}, stack.ToSlice())
+
+	stack.Clear()
+	assert.Equal(t, 0, stack.Len())
+}
+
+func TestUndoStack(t *testing.T) {
+	tests := []testUndoStack{
+		{
+			testName:        "Undo and redo a single action",
+			limit:           0,
+			operations:      []string{"do", "undo", "redo"},
+			values:          []string{"a", "", ""},
+			expectedResults: []string{"", "a", "a"},
+			expectedHistory: []string{"a"},
+			expectedRedoLen: 0,
+		},
+		{
+			testName:        "New action clears redo history",
+			limit:           0,
+			operations:      []string{"do", "do", "undo", "do", "redo"},
+			values:          []string{"a", "b", "", "c", ""},
+			expectedResults: []string{"", "", "b", "", "error"},
+			expectedHistory: []string{"a", "c"},
+			expectedRedoLen: 0,
+		},
+		{
+			testName:        "Bounded history drops the oldest action",
+			limit:           2,
+			operations:      []string{"do", "do", "do", "undo", "undo", "undo"},
+			values:          []string{"a", "b", "c", "", "", ""},
+			expectedResults: []string{"", "", "", "c", "b", "error"},
+			expectedHistory: []string{},
+			expectedRedoLen: 2,
+		},
+		{
+			testName:        "Undo on empty history",
+			limit:           3,
+			operations:      []string{"undo", "redo"},
+			values:          []string{"", ""},
+			expectedResults: []string{"error", "error"},
+			expectedHistory: []string{},
+			expectedRedoLen: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			history := data_structures.NewUndoStack[string](test.limit)
+
+			for i, op := range test.operations {
+				var result string
+				var err error
+
+				switch op {
+				case "do":
+					history.Do(test.values[i])
+				case "undo":
+					result, err = history.Undo()
+				case "redo":
+					result, err = history.Redo()
+				}
+
+				if err != nil {
+					result = "error"
+				}
+
+				assert.Equal(t, test.expectedResults[i], result, "operation %d", i)
+			}
+
+			assert.Equal(t, test.expectedHistory, history.History())
+			assert.Equal(t, test.expectedRedoLen, history.RedoLen())
+		})
+	}
+}
+
+func TestUndoStack_Errors(t *testing.T) {
+	history := data_structures.NewUndoStack[int](1)
+
+	_, err := history.Undo()
+	assert.ErrorIs(t, err, data_structures.ErrNothingToUndo)
+
+	_, err = history.Redo()
+	assert.ErrorIs(t, err, data_structures.ErrNothingToRedo)
+
+	history.Do(1)
+	history.Do(2)
+	assert.Equal(t, 1, history.UndoLen())
+	assert.True(t, history.CanUndo())
+	assert.False(t, history.CanRedo())
+
+	history.Clear()
+	assert.False(t, history.CanUndo())
+}
+
+func TestUndoStack_LoweredLimit(t *testing.T) {
+	history := data_structures.NewUndoStack[int](5)
+	for i := 1; i <= 5; i++ {
+		history.Do(i)
+	}
+
+	history.Limit = 2
+	history.Do(6)
+	assert.Equal(t, []int{5, 6}, history.History())
+
+	history.Do(7)
+	assert.Equal(t, []int{6, 7}, history.History())
+
+	action, err := history.Undo()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, action)
+
+	action, err = history.Redo()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, action)
+	assert.Equal(t, []int{6, 7}, history.History())
+
+	history.Limit = 0
+	history.Do(8)
+	assert.Equal(t, []int{6, 7, 8}, history.History())
+}
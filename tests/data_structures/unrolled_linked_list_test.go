@@ -0,0 +1,378 @@
+package data_structures_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/stretchr/testify/assert"
+)
+
+type testInsertAtPosUnrolledList struct {
+	testName      string
+	nodeCapacity  int
+	initialValues []int
+	insertValue   int
+	position      int
+	expectedList  []int
+	expectedError error
+}
+
+type testRemoveAtPosUnrolledList struct {
+	testName      string
+	nodeCapacity  int
+	initialValues []int
+	position      int
+	expectedList  []int
+	expectedError error
+}
+
+func newUnrolledList(nodeCapacity int, values []int) *linked_lists.UnrolledLinkedList[int] {
+	list := linked_lists.NewUnrolledLinkedList(nodeCapacity, func(a, b int) bool { return a == b })
+	for _, v := range values {
+		list.InsertAtEnd(v)
+	}
+
+	return list
+}
+
+func TestUnrolledLinkedList_DefaultCapacity(t *testing.T) {
+	list := newUnrolledList(0, nil)
+	assert.Equal(t, linked_lists.DefaultUnrolledNodeCapacity, list.NodeCapacity)
+
+	list = newUnrolledList(1, nil)
+	assert.Equal(t, linked_lists.DefaultUnrolledNodeCapacity, list.NodeCapacity)
+
+	list = newUnrolledList(8, nil)
+	assert.Equal(t, 8, list.NodeCapacity)
+}
+
+func TestUnrolledLinkedList_InsertAtPos(t *testing.T) {
+	tests := []testInsertAtPosUnrolledList{
+		{
+			testName:      "Insert into empty unrolled linked list",
+			nodeCapacity:  4,
+			initialValues: []int{},
+			insertValue:   1,
+			position:      0,
+			expectedList:  []int{1},
+		},
+		{
+			testName:      "Insert into the middle of a full node",
+			nodeCapacity:  4,
+			initialValues: []int{1, 2, 4, 5},
+			insertValue:   3,
+			position:      2,
+			expectedList:  []int{1, 2, 3, 4, 5},
+		},
+		{
+			testName:      "Insert at the beginning spanning several nodes",
+			nodeCapacity:  2,
+			initialValues: []int{2, 3, 4, 5, 6},
+			insertValue:   1,
+			position:      0,
+			expectedList:  []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			testName:      "Insert at the end spanning several nodes",
+			nodeCapacity:  3,
+			initialValues: []int{1, 2, 3, 4, 5, 6},
+			insertValue:   7,
+			position:      6,
+			expectedList:  []int{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			testName:      "Insert at invalid position",
+			nodeCapacity:  3,
+			initialValues: []int{1, 2},
+			insertValue:   3,
+			position:      5,
+			expectedList:  []int{1, 2},
+			expectedError: linked_lists.ErrInvalidPos,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newUnrolledList(test.nodeCapacity, test.initialValues)
+
+			err := list.InsertAtPos(test.insertValue, test.position)
+			assert.ErrorIs(t, err, test.expectedError)
+
+			result, _ := list.LinkedListToSlice()
+			assert.Equal(t, test.expectedList, result)
+			assert.Equal(t, len(test.expectedList), list.Len())
+		})
+	}
+}
+
+func TestUnrolledLinkedList_RemoveNodeAtPos(t *testing.T) {
+	tests := []testRemoveAtPosUnrolledList{
+		{
+			testName:      "Remove the only element",
+			nodeCapacity:  4,
+			initialValues: []int{1},
+			position:      0,
+			expectedList:  []int(nil),
+		},
+		{
+			testName:      "Remove from the middle",
+			nodeCapacity:  2,
+			initialValues: []int{1, 2, 3, 4, 5},
+			position:      2,
+			expectedList:  []int{1, 2, 4, 5},
+		},
+		{
+			testName:      "Remove the last element",
+			nodeCapacity:  3,
+			initialValues: []int{1, 2, 3, 4},
+			position:      3,
+			expectedList:  []int{1, 2, 3},
+		},
+		{
+			testName:      "Remove at invalid position",
+			nodeCapacity:  3,
+			initialValues: []int{1, 2, 3},
+			position:      3,
+			expectedList:  []int{1, 2, 3},
+			expectedError: linked_lists.ErrInvalidPos,
+		},
+		{
+			testName:      "Remove from empty list",
+			nodeCapacity:  3,
+			initialValues: []int{},
+			position:      0,
+			expectedList:  []int(nil),
+			expectedError: linked_lists.ErrInvalidPos,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			list := newUnrolledList(test.nodeCapacity, test.initialValues)
+
+			err := list.RemoveNodeAtPosition(test.position)
+			assert.ErrorIs(t, err, test.expectedError)
+
+			result, _ := list.LinkedListToSlice()
+			assert.Equal(t, test.expectedList, result)
+		})
+	}
+}
+
+func TestUnrolledLinkedList_RemoveFirstAndLast(t *testing.T) {
+	list := newUnrolledList(2, []int{1, 2, 3, 4, 5})
+
+	assert.NoError(t, list.RemoveFirstNode())
+	assert.NoError(t, list.RemoveLastNode())
+
+	result, err := list.LinkedListToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4}, result)
+
+	empty := newUnrolledList(2, nil)
+	assert.ErrorIs(t, empty.RemoveFirstNode(), linked_lists.ErrListEmpty)
+	assert.ErrorIs(t, empty.RemoveLastNode(), linked_lists.ErrListEmpty)
+
+	_, err = empty.LinkedListToSlice()
+	assert.ErrorIs(t, err, linked_lists.ErrListEmpty)
+}
+
+func TestUnrolledLinkedList_FindNodeAndReverse(t *testing.T) {
+	list := newUnrolledList(3, []int{10, 20, 30, 40, 50, 60, 70})
+
+	index, err := list.FindNode(50)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, index)
+
+	_, err = list.FindNode(55)
+	assert.ErrorIs(t, err, linked_lists.ErrValueNotFound)
+
+	list.Reverse()
+
+	result, err := list.LinkedListToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{70, 60, 50, 40, 30, 20, 10}, result)
+
+	index, err = list.FindNode(50)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	list.InsertAtEnd(0)
+	list.InsertAtBeginning(80)
+
+	result, err = list.LinkedListToSlice()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{80, 70, 60, 50, 40, 30, 20, 10, 0}, result)
+}
+
+func TestUnrolledLinkedList_All(t *testing.T) {
+	list := newUnrolledList(3, []int{10, 20, 30, 40, 50, 60, 70})
+
+	var values []int
+	list.All()(func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	assert.Equal(t, []int{10, 20, 30, 40, 50, 60, 70}, values)
+
+	// Stopping in the second node must not visit the rest.
+	values = nil
+	list.All()(func(value int) bool {
+		values = append(values, value)
+		return len(values) < 4
+	})
+	assert.Equal(t, []int{10, 20, 30, 40}, values)
+
+	assert.NoError(t, list.RemoveNodeAtPosition(3))
+
+	values = nil
+	list.All()(func(value int) bool {
+		values = append(values, value)
+		return true
+	})
+	assert.Equal(t, []int{10, 20, 30, 50, 60, 70}, values)
+
+	empty := linked_lists.NewUnrolledLinkedList[int](3, nil)
+	empty.All()(func(int) bool {
+		t.Fatal("empty list yielded a value")
+		return false
+	})
+}
+
+func TestUnrolledLinkedList_MatchesSliceModel(t *testing.T) {
+	for _, nodeCapacity := range []int{2, 3, 4, 7, 16} {
+		rng := rand.New(rand.NewSource(int64(nodeCapacity)))
+		list := newUnrolledList(nodeCapacity, nil)
+		var model []int
+
+		for step := 0; step < 2000; step++ {
+			if len(model) == 0 || rng.Intn(3) > 0 {
+				pos := rng.Intn(len(model) + 1)
+				value := rng.Intn(1000)
+
+				assert.NoError(t, list.InsertAtPos(value, pos))
+				model = append(model[:pos], append([]int{value}, model[pos:]...)...)
+			} else {
+				pos := rng.Intn(len(model))
+
+				assert.NoError(t, list.RemoveNodeAtPosition(pos))
+				model = append(model[:pos], model[pos+1:]...)
+			}
+
+			if step%250 == 0 {
+				list.Reverse()
+				for i, j := 0, len(model)-1; i < j; i, j = i+1, j-1 {
+					model[i], model[j] = model[j], model[i]
+				}
+			}
+		}
+
+		result, _ := list.LinkedListToSlice()
+		assert.Equal(t, model, result, "capacity %d", nodeCapacity)
+		assert.Equal(t, len(model), list.Len())
+
+		for node := list.Head; node != nil; node = node.Next {
+			assert.NotEmpty(t, node.Values)
+			assert.LessOrEqual(t, len(node.Values), nodeCapacity)
+		}
+	}
+}
+
+const benchListSize = 4096
+
+func benchInsertValues() []int {
+	rng := rand.New(rand.NewSource(42))
+	values := make([]int, benchListSize)
+	for i := range values {
+		values[i] = rng.Intn(benchListSize)
+	}
+
+	return values
+}
+
+func BenchmarkSinglyLinkedList_InsertAtPosMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := linked_lists.NewSinglyLinkedList(func(a, b int) bool { return a == b })
+		for j, v := range benchInsertValues() {
+			_ = list.InsertAtPos(v, j/2)
+		}
+	}
+}
+
+func BenchmarkDoublyLinkedList_InsertAtPosMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := linked_lists.NewDoublyLinkedList(func(a, b int) bool { return a == b })
+		for j, v := range benchInsertValues() {
+			_ = list.InsertAtPos(v, j/2)
+		}
+	}
+}
+
+func BenchmarkUnrolledLinkedList_InsertAtPosMiddle(b *testing.B) {
+	for _, nodeCapacity := range []int{16, 64, 256} {
+		b.Run(fmt.Sprintf("capacity=%d", nodeCapacity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				list := newUnrolledList(nodeCapacity, nil)
+				for j, v := range benchInsertValues() {
+					_ = list.InsertAtPos(v, j/2)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDoublyLinkedList_FindNode(b *testing.B) {
+	list := linked_lists.NewDoublyLinkedList(func(a, b int) bool { return a == b })
+	for i := 0; i < benchListSize; i++ {
+		list.InsertAtEnd(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = list.FindNode(benchListSize - 1)
+	}
+}
+
+func BenchmarkUnrolledLinkedList_FindNode(b *testing.B) {
+	list := newUnrolledList(64, nil)
+	for i := 0; i < benchListSize; i++ {
+		list.InsertAtEnd(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = list.FindNode(benchListSize - 1)
+	}
+}
+
+func BenchmarkDoublyLinkedList_RemoveNodeAtPositionMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := linked_lists.NewDoublyLinkedList(func(a, b int) bool { return a == b })
+		for j := 0; j < benchListSize; j++ {
+			list.InsertAtEnd(j)
+		}
+		b.StartTimer()
+
+		for list.Len() > 2 {
+			_ = list.RemoveNodeAtPosition(list.Len() / 2)
+		}
+	}
+}
+
+func BenchmarkUnrolledLinkedList_RemoveNodeAtPositionMiddle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := newUnrolledList(64, nil)
+		for j := 0; j < benchListSize; j++ {
+			list.InsertAtEnd(j)
+		}
+		b.StartTimer()
+
+		for list.Len() > 2 {
+			_ = list.RemoveNodeAtPosition(list.Len() / 2)
+		}
+	}
+}
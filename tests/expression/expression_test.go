@@ -0,0 +1,371 @@
+package expression_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/expression"
+	"github.com/stretchr/testify/assert"
+)
+
+type testToPostfix struct {
+	testName        string
+	expression      string
+	expectedPostfix string
+}
+
+type testEvaluate struct {
+	testName       string
+	expression     string
+	vars           map[string]float64
+	expectedResult float64
+}
+
+type testEvaluateError struct {
+	testName       string
+	expression     string
+	vars           map[string]float64
+	expectedError  error
+	expectedColumn int
+}
+
+func TestToPostfix(t *testing.T) {
+	tests := []testToPostfix{
+		{
+			testName:        "Precedence of multiplication over addition",
+			expression:      "3 + 4 * 2",
+			expectedPostfix: "3 4 2 * +",
+		},
+		{
+			testName:        "Left associativity of subtraction",
+			expression:      "10 - 4 - 3",
+			expectedPostfix: "10 4 - 3 -",
+		},
+		{
+			testName:        "Right associativity of exponentiation",
+			expression:      "2 ^ 3 ^ 2",
+			expectedPostfix: "2 3 2 ^ ^",
+		},
+		{
+			testName:        "Parentheses override precedence",
+			expression:      "(3 + 4) * 2",
+			expectedPostfix: "3 4 + 2 *",
+		},
+		{
+			testName:        "Classic shunting-yard example",
+			expression:      "3 + 4 * 2 / (1 - 5) ^ 2 ^ 3",
+			expectedPostfix: "3 4 2 * 1 5 - 2 3 ^ ^ / +",
+		},
+		{
+			testName:        "Unary minus binds looser than exponentiation",
+			expression:      "-2 ^ 2",
+			expectedPostfix: "2 2 ^ u-",
+		},
+		{
+			testName:        "Unary minus binds tighter than multiplication",
+			expression:      "-a * b",
+			expectedPostfix: "a u- b *",
+		},
+		{
+			testName:        "Unary minus after binary operator",
+			expression:      "x - -y",
+			expectedPostfix: "x y u- -",
+		},
+		{
+			testName:        "Function calls with arguments",
+			expression:      "max(a, min(b, 2), 3) + gcd(12, 18)",
+			expectedPostfix: "a b 2 min/2 3 max/3 12 18 gcd/2 +",
+		},
+		{
+			testName:        "Boolean operators",
+			expression:      "a < 3 && !b || c == 1",
+			expectedPostfix: "a 3 < b u! && c 1 == ||",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			postfix, err := expression.ToPostfix(test.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedPostfix, expression.FormatPostfix(postfix))
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []testEvaluate{
+		{
+			testName:       "Integer arithmetic",
+			expression:     "3 + 4 * 2 - 6 / 3",
+			expectedResult: 9,
+		},
+		{
+			testName:       "Floating point and exponent literals",
+			expression:     "1.5e2 + .5",
+			expectedResult: 150.5,
+		},
+		{
+			testName:       "Exponentiation is right associative",
+			expression:     "2 ^ 3 ^ 2",
+			expectedResult: 512,
+		},
+		{
+			testName:       "Unary minus",
+			expression:     "-2 ^ 2 + -(3 - 5)",
+			expectedResult: -2,
+		},
+		{
+			testName:       "Modulo",
+			expression:     "17 % 5",
+			expectedResult: 2,
+		},
+		{
+			testName:       "Variables",
+			expression:     "price * (1 - discount)",
+			vars:           map[string]float64{"price": 200, "discount": 0.25},
+			expectedResult: 150,
+		},
+		{
+			testName:       "Boolean rule is true",
+			expression:     "age >= 18 && (country == 1 || vip)",
+			vars:           map[string]float64{"age": 20, "country": 2, "vip": 1},
+			expectedResult: 1,
+		},
+		{
+			testName:       "Boolean rule is false",
+			expression:     "!(age >= 18) || age > 65",
+			vars:           map[string]float64{"age": 30},
+			expectedResult: 0,
+		},
+		{
+			testName:       "Min and max",
+			expression:     "max(1, x, 3) - min(4, -2)",
+			vars:           map[string]float64{"x": 7},
+			expectedResult: 9,
+		},
+		{
+			testName:       "Gcd and lcm from math_algo",
+			expression:     "gcd(84, 36, 60) + lcm(4, 6)",
+			expectedResult: 24,
+		},
+		{
+			testName:       "Gcd and lcm at the edge of the exact float range",
+			expression:     "gcd(-9007199254740992, 6) + lcm(-4503599627370496, 2)",
+			expectedResult: 4503599627370498,
+		},
+		{
+			testName:       "Nested function calls with expressions",
+			expression:     "abs(min(2 - 10, 3) * 2)",
+			expectedResult: 16,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			result, err := expression.Evaluate(test.expression, test.vars)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expectedResult, result, 1e-9)
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	tests := []testEvaluateError{
+		{
+			testName:       "Empty expression",
+			expression:     "   ",
+			expectedError:  expression.ErrEmptyExpression,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Unexpected character",
+			expression:     "1 + $",
+			expectedError:  expression.ErrUnexpectedChar,
+			expectedColumn: 5,
+		},
+		{
+			testName:       "Missing operand at the end",
+			expression:     "1 +",
+			expectedError:  expression.ErrUnexpectedEnd,
+			expectedColumn: 4,
+		},
+		{
+			testName:       "Two operands in a row",
+			expression:     "1 2",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 3,
+		},
+		{
+			testName:       "Binary operator at the start",
+			expression:     "* 2",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Unclosed parenthesis",
+			expression:     "(1 + (2 * 3)",
+			expectedError:  expression.ErrMismatchedParens,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Unmatched closing parenthesis",
+			expression:     "1 + 2)",
+			expectedError:  expression.ErrMismatchedParens,
+			expectedColumn: 6,
+		},
+		{
+			testName:       "Empty parentheses",
+			expression:     "2 * ()",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 6,
+		},
+		{
+			testName:       "Comma outside of function call",
+			expression:     "(1, 2)",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 3,
+		},
+		{
+			testName:       "Unknown function",
+			expression:     "1 + foo(2)",
+			expectedError:  expression.ErrUnknownFunction,
+			expectedColumn: 5,
+		},
+		{
+			testName:       "Wrong number of arguments",
+			expression:     "abs(1, 2)",
+			expectedError:  expression.ErrWrongArgCount,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Unary-only operator in binary position",
+			expression:     "1 ! 2",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 3,
+		},
+		{
+			testName:       "Missing argument",
+			expression:     "max(1, )",
+			expectedError:  expression.ErrUnexpectedToken,
+			expectedColumn: 8,
+		},
+		{
+			testName:       "Unknown variable",
+			expression:     "x + y",
+			vars:           map[string]float64{"x": 1},
+			expectedError:  expression.ErrUnknownVariable,
+			expectedColumn: 5,
+		},
+		{
+			testName:       "Division by zero",
+			expression:     "10 / (5 - 5)",
+			expectedError:  expression.ErrDivisionByZero,
+			expectedColumn: 4,
+		},
+		{
+			testName:       "Gcd of non-integer",
+			expression:     "2 + gcd(4, 2.5)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 5,
+		},
+		{
+			testName:       "Gcd beyond int64",
+			expression:     "gcd(1e20, 6)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Gcd of minimum int64",
+			expression:     "gcd(-9223372036854775808, 6)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Gcd beyond exact float range",
+			expression:     "gcd(1e16, 3)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Lcm overflow",
+			expression:     "lcm(4e18, 6e18)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 1,
+		},
+		{
+			testName:       "Lcm beyond exact float range",
+			expression:     "lcm(9007199254740991, 2)",
+			expectedError:  expression.ErrInvalidArgument,
+			expectedColumn: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			_, err := expression.Evaluate(test.expression, test.vars)
+			assert.ErrorIs(t, err, test.expectedError)
+
+			var exprErr *expression.Error
+			if assert.True(t, errors.As(err, &exprErr)) {
+				assert.Equal(t, test.expectedColumn, exprErr.Column)
+			}
+		})
+	}
+}
+
+func TestEvaluate_ErrorMessage(t *testing.T) {
+	_, err := expression.Evaluate("1 + foo(2)", nil)
+	assert.EqualError(t, err, "column 5: unknown function: foo")
+}
+
+func TestToPostfix_UnaryOnlyOperatorInBinaryPosition(t *testing.T) {
+	postfix, err := expression.ToPostfix("x + 1 ! 2")
+	assert.Nil(t, postfix)
+	assert.ErrorIs(t, err, expression.ErrUnexpectedToken)
+
+	var exprErr *expression.Error
+	if assert.True(t, errors.As(err, &exprErr)) {
+		assert.Equal(t, 7, exprErr.Column)
+	}
+
+	assert.EqualError(t, err, "column 7: unexpected token: '!'")
+}
+
+func TestParser_CustomFunctions(t *testing.T) {
+	parser := expression.NewParser()
+	parser.Functions["clamp"] = expression.Function{
+		MinArgs: 3,
+		MaxArgs: 3,
+		Apply: func(args []float64) (float64, error) {
+			return max(args[1], min(args[0], args[2])), nil
+		},
+	}
+	parser.Functions["zero"] = expression.Function{
+		MinArgs: 0,
+		MaxArgs: 0,
+		Apply:   func(args []float64) (float64, error) { return 0, nil },
+	}
+
+	result, err := parser.Evaluate("clamp(x * 2, 0, 10) + zero()", map[string]float64{"x": 7})
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, result)
+
+	_, err = expression.Evaluate("clamp(1, 2, 3)", nil)
+	assert.ErrorIs(t, err, expression.ErrUnknownFunction)
+}
+
+func TestEvalPostfix_Reusable(t *testing.T) {
+	parser := expression.NewParser()
+
+	postfix, err := parser.ToPostfix("a * a + b")
+	assert.NoError(t, err)
+
+	for _, a := range []float64{1, 2, 3} {
+		result, err := parser.EvalPostfix(postfix, map[string]float64{"a": a, "b": 1})
+		assert.NoError(t, err)
+		assert.Equal(t, a*a+1, result)
+	}
+
+	_, err = parser.EvalPostfix(postfix[:2], map[string]float64{"a": 1})
+	assert.ErrorIs(t, err, expression.ErrMalformedPostfix)
+}
@@ -1 +1,84 @@
 package math_algo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/math_algo"
+	"github.com/stretchr/testify/assert"
+)
+
+type testGCD struct {
+	testName    string
+	a           int64
+	b           int64
+	expectedGCD int64
+	expectedLCM int64
+}
+
+func TestGCDAndLCM(t *testing.T) {
+	tests := []testGCD{
+		{
+			testName:    "Both zero",
+			a:           0,
+			b:           0,
+			expectedGCD: 0,
+			expectedLCM: 0,
+		},
+		{
+			testName:    "One zero",
+			a:           0,
+			b:           15,
+			expectedGCD: 15,
+			expectedLCM: 0,
+		},
+		{
+			testName:    "Coprime numbers",
+			a:           17,
+			b:           5,
+			expectedGCD: 1,
+			expectedLCM: 85,
+		},
+		{
+			testName:    "Common divisor",
+			a:           84,
+			b:           36,
+			expectedGCD: 12,
+			expectedLCM: 252,
+		},
+		{
+			testName:    "Negative numbers",
+			a:           -84,
+			b:           36,
+			expectedGCD: 12,
+			expectedLCM: 252,
+		},
+		{
+			testName:    "Equal numbers",
+			a:           42,
+			b:           42,
+			expectedGCD: 42,
+			expectedLCM: 42,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expectedGCD, math_algo.GCD(test.a, test.b))
+			assert.Equal(t, test.expectedGCD, math_algo.GCD(test.b, test.a))
+			assert.Equal(t, test.expectedLCM, math_algo.LCM(test.a, test.b))
+		})
+	}
+
+	assert.Equal(t, uint8(6), math_algo.GCD(uint8(54), uint8(24)))
+}
+
+func TestGCD_MinimumValue(t *testing.T) {
+	assert.Equal(t, int64(2), math_algo.GCD(int64(math.MinInt64), 6))
+	assert.Equal(t, int64(2), math_algo.GCD(6, int64(math.MinInt64)))
+	assert.Equal(t, int64(1), math_algo.GCD(int64(math.MinInt64), -1))
+	assert.Equal(t, int8(8), math_algo.GCD(int8(-128), int8(24)))
+
+	// -MinInt64 does not fit in int64.
+	assert.Equal(t, int64(math.MinInt64), math_algo.GCD(int64(math.MinInt64), 0))
+}
@@ -1 +1,348 @@
 package search_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBounds struct {
+	testName           string
+	data               []int
+	target             int
+	expectedLowerBound int
+	expectedUpperBound int
+	expectedFound      bool
+}
+
+type testSearchFloat struct {
+	testName      string
+	lo, hi        float64
+	pred          func(x float64) bool
+	tolerance     float64
+	maxIterations int
+	expected      float64
+	delta         float64
+}
+
+func TestBounds(t *testing.T) {
+	data := []int{1, 3, 3, 3, 5, 8, 8, 13}
+
+	tests := []testBounds{
+		{testName: "Empty slice", data: nil, target: 4, expectedLowerBound: 0, expectedUpperBound: 0},
+		{testName: "Single match", data: []int{4}, target: 4, expectedLowerBound: 0, expectedUpperBound: 1, expectedFound: true},
+		{testName: "Before all elements", data: data, target: 0, expectedLowerBound: 0, expectedUpperBound: 0},
+		{testName: "First element", data: data, target: 1, expectedLowerBound: 0, expectedUpperBound: 1, expectedFound: true},
+		{testName: "Run of duplicates", data: data, target: 3, expectedLowerBound: 1, expectedUpperBound: 4, expectedFound: true},
+		{testName: "Missing value in the middle", data: data, target: 6, expectedLowerBound: 5, expectedUpperBound: 5},
+		{testName: "Duplicates near the end", data: data, target: 8, expectedLowerBound: 5, expectedUpperBound: 7, expectedFound: true},
+		{testName: "Last element", data: data, target: 13, expectedLowerBound: 7, expectedUpperBound: 8, expectedFound: true},
+		{testName: "After all elements", data: data, target: 100, expectedLowerBound: 8, expectedUpperBound: 8},
+		{testName: "All equal", data: []int{2, 2, 2, 2, 2}, target: 2, expectedLowerBound: 0, expectedUpperBound: 5, expectedFound: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expectedLowerBound, search.LowerBound(test.data, test.target))
+			assert.Equal(t, test.expectedUpperBound, search.UpperBound(test.data, test.target))
+
+			lo, hi := search.EqualRange(test.data, test.target)
+			assert.Equal(t, test.expectedLowerBound, lo)
+			assert.Equal(t, test.expectedUpperBound, hi)
+
+			index, found := search.BinarySearch(test.data, test.target)
+			assert.Equal(t, test.expectedLowerBound, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestBoundsFunc_Descending(t *testing.T) {
+	data := []string{"pear", "kiwi", "kiwi", "fig", "apple"}
+	greater := func(a, b string) bool { return a > b }
+
+	assert.Equal(t, 1, search.LowerBoundFunc(data, "kiwi", greater))
+	assert.Equal(t, 3, search.UpperBoundFunc(data, "kiwi", greater))
+
+	lo, hi := search.EqualRangeFunc(data, "kiwi", greater)
+	assert.Equal(t, [2]int{1, 3}, [2]int{lo, hi})
+
+	index, found := search.BinarySearchFunc(data, "banana", greater)
+	assert.Equal(t, 4, index)
+	assert.False(t, found)
+}
+
+func TestBoundsFunc_RecordsByKey(t *testing.T) {
+	type event struct {
+		at   int
+		name string
+	}
+
+	events := []event{{1, "a"}, {4, "b"}, {4, "c"}, {9, "d"}}
+	byTime := func(a, b event) bool { return a.at < b.at }
+
+	lo, hi := search.EqualRangeFunc(events, event{at: 4}, byTime)
+	assert.Equal(t, []event{{4, "b"}, {4, "c"}}, events[lo:hi])
+}
+
+func TestBounds_Property(t *testing.T) {
+	property := func(data []int8, target int8) bool {
+		slices.Sort(data)
+
+		lower, upper := 0, 0
+		for _, v := range data {
+			if v < target {
+				lower++
+			}
+			if v <= target {
+				upper++
+			}
+		}
+
+		lo, hi := search.EqualRange(data, target)
+		expectedIndex, expectedFound := slices.BinarySearch(data, target)
+		index, found := search.BinarySearch(data, target)
+
+		return search.LowerBound(data, target) == lower && search.UpperBound(data, target) == upper &&
+			lo == lower && hi == upper && index == expectedIndex && found == expectedFound
+	}
+
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 1000}))
+}
+
+func TestSearch(t *testing.T) {
+	calls := 0
+	first := search.Search(1000, func(i int) bool {
+		calls++
+		assert.True(t, i >= 0 && i < 1000)
+
+		return i*i >= 500
+	})
+
+	assert.Equal(t, 23, first)
+	assert.LessOrEqual(t, calls, 10)
+
+	assert.Equal(t, 0, search.Search(0, func(int) bool { return true }))
+	assert.Equal(t, 10, search.Search(10, func(int) bool { return false }))
+	assert.Equal(t, 0, search.Search(10, func(int) bool { return true }))
+}
+
+func TestSearchInt(t *testing.T) {
+	// The smallest integer square root bound: the first x with x*x >= 10^12.
+	root := search.SearchInt(int64(0), int64(1<<31), func(x int64) bool { return x*x >= 1_000_000_000_000 })
+	assert.Equal(t, int64(1_000_000), root)
+
+	assert.Equal(t, int8(-100), search.SearchInt(int8(math.MinInt8), int8(math.MaxInt8), func(x int8) bool { return x >= -100 }))
+	assert.Equal(t, int8(math.MaxInt8), search.SearchInt(int8(math.MinInt8), int8(math.MaxInt8), func(int8) bool { return false }))
+	assert.Equal(t, uint64(math.MaxUint64-1), search.SearchInt(uint64(0), uint64(math.MaxUint64), func(x uint64) bool {
+		return x >= math.MaxUint64-1
+	}))
+	assert.Equal(t, 3, search.SearchInt(5, 3, func(int) bool { return true }))
+}
+
+func TestSearchFloat(t *testing.T) {
+	tests := []testSearchFloat{
+		{
+			testName:  "Square root of two",
+			lo:        0,
+			hi:        2,
+			pred:      func(x float64) bool { return x*x >= 2 },
+			tolerance: 1e-12,
+			expected:  math.Sqrt2,
+			delta:     1e-12,
+		},
+		{
+			testName: "Exact boundary with zero tolerance",
+			lo:       0,
+			hi:       1,
+			pred:     func(x float64) bool { return x >= 0.1 },
+			expected: 0.1,
+		},
+		{
+			testName:      "Iteration limit bounds the precision",
+			lo:            0,
+			hi:            1024,
+			pred:          func(x float64) bool { return x >= 100 },
+			maxIterations: 10,
+			expected:      100,
+			delta:         1,
+		},
+		{
+			testName:  "Answer at the lower bound",
+			lo:        3,
+			hi:        4,
+			pred:      func(float64) bool { return true },
+			tolerance: 1e-9,
+			expected:  3,
+			delta:     1e-9,
+		},
+		{
+			testName: "Interval spanning the whole float range",
+			lo:       -math.MaxFloat64,
+			hi:       math.MaxFloat64,
+			pred:     func(x float64) bool { return x >= -1.5 },
+			expected: -1.5,
+		},
+		{
+			testName:  "Minimal speed to travel 100 km in 3 hours",
+			lo:        0,
+			hi:        1000,
+			pred:      func(speed float64) bool { return 100/speed <= 3 },
+			tolerance: 1e-9,
+			expected:  100.0 / 3,
+			delta:     1e-9,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			x, err := search.SearchFloat(test.lo, test.hi, test.pred, test.tolerance, test.maxIterations)
+
+			assert.NoError(t, err)
+			assert.True(t, test.pred(x))
+			assert.InDelta(t, test.expected, x, test.delta)
+		})
+	}
+}
+
+func TestSearchFloat_Float32(t *testing.T) {
+	x, err := search.SearchFloat(float32(0), float32(10), func(x float32) bool { return x*x*x >= 27 }, 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, float32(3), x)
+}
+
+func TestSearchFloat_Errors(t *testing.T) {
+	pred := func(x float64) bool { return x >= 1 }
+
+	_, err := search.SearchFloat(2, 1, pred, 0, 0)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.SearchFloat(math.NaN(), 1, pred, 0, 0)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.SearchFloat(0, math.Inf(1), pred, 0, 0)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.SearchFloat(0, 2, pred, -1, 0)
+	assert.ErrorIs(t, err, search.ErrInvalidTolerance)
+
+	_, err = search.SearchFloat(0, 0.5, pred, 0, 0)
+	assert.ErrorIs(t, err, search.ErrNoSolution)
+}
+
+func BenchmarkLowerBound(b *testing.B) {
+	data := make([]int, 1<<20)
+	for i := range data {
+		data[i] = 2 * i
+	}
+
+	b.Run("LowerBound", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = search.LowerBound(data, i%(2*len(data)))
+		}
+	})
+
+	b.Run("LowerBoundFunc", func(b *testing.B) {
+		less := func(a, b int) bool { return a < b }
+		for i := 0; i < b.N; i++ {
+			_ = search.LowerBoundFunc(data, i%(2*len(data)), less)
+		}
+	})
+
+	b.Run("slices.BinarySearch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = slices.BinarySearch(data, i%(2*len(data)))
+		}
+	})
+}
+
+// assertMatchesBinarySearch checks on random sorted slices that searchFunc returns the same
+// position and found flag as slices.BinarySearch.
+func assertMatchesBinarySearch(t *testing.T, searchFunc func(data []int16, target int16) (int, bool)) {
+	t.Helper()
+
+	property := func(data []int16, target int16, dense bool) bool {
+		if dense {
+			// Narrow the values so that duplicates and hits are frequent.
+			for i := range data {
+				data[i] %= 16
+			}
+			target %= 16
+		}
+
+		slices.Sort(data)
+
+		expectedIndex, expectedFound := slices.BinarySearch(data, target)
+		index, found := searchFunc(data, target)
+
+		return index == expectedIndex && found == expectedFound
+	}
+
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 2000}))
+}
+
+func BenchmarkSortedSearches(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+
+	uniform := make([]float64, 1<<20)
+	for i := range uniform {
+		uniform[i] = float64(8*i + rng.Intn(8))
+	}
+
+	skewed := make([]float64, 1<<20)
+	for i := range skewed {
+		skewed[i] = math.Pow(1.00002, float64(i))
+	}
+
+	small := uniform[:16]
+
+	randomTargets := func(data []float64, positions int) []float64 {
+		targets := make([]float64, 1024)
+		for i := range targets {
+			targets[i] = data[rng.Intn(positions)]
+		}
+
+		return targets
+	}
+
+	// Interpolation search wins on uniform keys and falls back to bisection on skewed ones, exponential
+	// search beats binary search when the answer is near the start, and jump search is only competitive
+	// on small slices.
+	scenarios := []struct {
+		name    string
+		data    []float64
+		targets []float64
+	}{
+		{name: "Uniform", data: uniform, targets: randomTargets(uniform, len(uniform))},
+		{name: "Skewed", data: skewed, targets: randomTargets(skewed, len(skewed))},
+		{name: "NearStart", data: uniform, targets: randomTargets(uniform, 64)},
+		{name: "Small", data: small, targets: randomTargets(small, len(small))},
+	}
+
+	searches := []struct {
+		name       string
+		searchFunc func(data []float64, target float64) (int, bool)
+	}{
+		{name: "Binary", searchFunc: search.BinarySearch[float64]},
+		{name: "Exponential", searchFunc: search.ExponentialSearch[float64]},
+		{name: "Interpolation", searchFunc: search.InterpolationSearch[float64]},
+		{name: "Jump", searchFunc: search.JumpSearch[float64]},
+		{name: "Fibonacci", searchFunc: search.FibonacciSearch[float64]},
+	}
+
+	for _, scenario := range scenarios {
+		for _, s := range searches {
+			b.Run(scenario.name+"/"+s.name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, _ = s.searchFunc(scenario.data, scenario.targets[i%len(scenario.targets)])
+				}
+			})
+		}
+	}
+}
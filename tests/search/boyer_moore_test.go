@@ -0,0 +1,32 @@
+package search_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoyerMoore_Tables(t *testing.T) {
+	b := search.NewBoyerMoore("ANPANMAN")
+
+	assert.Equal(t, 6, b.BadCharacter['A'])
+	assert.Equal(t, 5, b.BadCharacter['M'])
+	assert.Equal(t, 7, b.BadCharacter['N'])
+	assert.Equal(t, 2, b.BadCharacter['P'])
+	assert.Equal(t, -1, b.BadCharacter['Z'])
+
+	// With nothing matched the pattern moves by one. After "AN" matched, "AN" occurs 3 back preceded by
+	// "P" instead of "M". After "N" alone matched, both earlier "N" are preceded by the same "A", so the
+	// pattern moves past it. Longer suffixes only realign the border "AN" of the whole pattern.
+	assert.Equal(t, []int{6, 6, 6, 6, 6, 6, 3, 8, 1}, b.GoodSuffix)
+}
+
+func TestBoyerMoore_LongPatterns(t *testing.T) {
+	pattern := "connection reset by peer"
+	text := strings.Repeat("connection reset by beer, ", 1000) + pattern + strings.Repeat(" by peer", 100)
+
+	assert.Equal(t, naiveSearch(text, pattern), search.BoyerMooreSearch(text, pattern))
+	assert.Equal(t, []int{len(text) - len(pattern) - 800}, search.NewBoyerMoore(pattern).FindAllString(text))
+}
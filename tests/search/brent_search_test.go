@@ -0,0 +1,109 @@
+package search_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrentSearch(t *testing.T) {
+	for _, test := range unimodalSearchTests {
+		t.Run(test.testName, func(t *testing.T) {
+			calls := 0
+			f := func(x float64) float64 {
+				calls++
+				return test.f(x)
+			}
+
+			optimum, err := search.BrentSearch(test.lo, test.hi, f, test.tolerance, 0, test.goal)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expectedArg, optimum.Arg, test.delta)
+			assert.InDelta(t, test.expectedValue, optimum.Value, test.delta)
+			assert.Equal(t, calls, optimum.Evaluations)
+		})
+	}
+}
+
+func TestBrentSearch_FewerEvaluationsOnSmoothFunctions(t *testing.T) {
+	f := func(x float64) float64 { return x*x*x*x - 3*x + math.Exp(-x) }
+
+	golden, err := search.GoldenSectionSearch(-2, 3, f, 1e-8, 0, search.Minimize)
+	assert.NoError(t, err)
+
+	brent, err := search.BrentSearch(-2, 3, f, 1e-8, 0, search.Minimize)
+	assert.NoError(t, err)
+
+	assert.InDelta(t, golden.Arg, brent.Arg, 1e-6)
+	assert.Less(t, brent.Evaluations, golden.Evaluations/2)
+}
+
+func TestBrentSearch_MaxIterations(t *testing.T) {
+	f := func(x float64) float64 { return math.Abs(x-0.123) + math.Sin(7*x)/100 }
+
+	optimum, err := search.BrentSearch(-10, 10, f, 0, 5, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6, optimum.Evaluations)
+}
+
+func TestBrentSearch_Float32(t *testing.T) {
+	f := func(x float32) float32 { return -(x - 0.25) * (x - 0.25) }
+
+	optimum, err := search.BrentSearch(float32(-1), float32(1), f, 0, 0, search.Maximize)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.25, float64(optimum.Arg), 1e-3)
+	assert.InDelta(t, 0, float64(optimum.Value), 1e-6)
+}
+
+func TestBrentSearch_NearMaximumInterval(t *testing.T) {
+	f := func(x float64) float64 { return math.Abs(x - 1e300) }
+
+	optimum, err := search.BrentSearch(-math.MaxFloat64/2, math.MaxFloat64/2, f, 0, 0, search.Minimize)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 1e300, optimum.Arg, 1e-6)
+	assert.Equal(t, f(optimum.Arg), optimum.Value)
+}
+
+func TestBrentSearch_InvalidArguments(t *testing.T) {
+	f := func(x float64) float64 { return x }
+
+	_, err := search.BrentSearch(1, math.NaN(), f, 0, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.BrentSearch(-math.MaxFloat64, math.MaxFloat64, f, 0, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.BrentSearch(-math.MaxFloat32, float32(math.MaxFloat32), func(x float32) float32 { return x }, 0, 1000, search.Maximize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.BrentSearch(0, 1, f, -1e-3, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidTolerance)
+}
+
+func BenchmarkUnimodalSearches(b *testing.B) {
+	f := func(x float64) float64 { return x*x*x*x - 3*x + math.Exp(-x) }
+
+	b.Run("GoldenSectionSearch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = search.GoldenSectionSearch(-2, 3, f, 1e-8, 0, search.Minimize)
+		}
+	})
+
+	b.Run("BrentSearch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = search.BrentSearch(-2, 3, f, 1e-8, 0, search.Minimize)
+		}
+	})
+
+	b.Run("TernarySearch", func(b *testing.B) {
+		g := func(x int) float64 { return f(float64(x) / 1e8) }
+
+		for i := 0; i < b.N; i++ {
+			_, _ = search.TernarySearch(-200_000_000, 300_000_000, g, search.Minimize)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package search_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSearchUnbounded struct {
+	testName      string
+	pred          func(i int) bool
+	expected      int
+	expectedFound bool
+}
+
+func TestExponentialSearch(t *testing.T) {
+	data := []int{1, 3, 3, 3, 5, 8, 8, 13}
+
+	tests := []testBounds{
+		{testName: "Empty slice", data: nil, target: 4, expectedLowerBound: 0},
+		{testName: "Before all elements", data: data, target: 0, expectedLowerBound: 0},
+		{testName: "First element", data: data, target: 1, expectedLowerBound: 0, expectedFound: true},
+		{testName: "Run of duplicates", data: data, target: 3, expectedLowerBound: 1, expectedFound: true},
+		{testName: "Missing element", data: data, target: 6, expectedLowerBound: 5},
+		{testName: "Last element", data: data, target: 13, expectedLowerBound: 7, expectedFound: true},
+		{testName: "After all elements", data: data, target: 20, expectedLowerBound: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			index, found := search.ExponentialSearch(test.data, test.target)
+
+			assert.Equal(t, test.expectedLowerBound, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestExponentialSearch_Property(t *testing.T) {
+	assertMatchesBinarySearch(t, search.ExponentialSearch[int16])
+}
+
+func TestExponentialSearchFunc_ProbesNearStart(t *testing.T) {
+	data := make([]int, 1<<20)
+	for i := range data {
+		data[i] = i
+	}
+
+	comparisons := 0
+	less := func(a, b int) bool {
+		comparisons++
+		return a < b
+	}
+
+	index, found := search.ExponentialSearchFunc(data, 5, less)
+
+	assert.Equal(t, 5, index)
+	assert.True(t, found)
+	assert.LessOrEqual(t, comparisons, 10)
+}
+
+func TestExponentialSearchUnbounded(t *testing.T) {
+	// An endless stream of squares, read on demand.
+	requested := 0
+	squares := func(i int) (int, bool) {
+		requested++
+		return i * i, true
+	}
+	less := func(a, b int) bool { return a < b }
+
+	index, found := search.ExponentialSearchUnbounded(squares, 1_000_000, less)
+	assert.Equal(t, 1000, index)
+	assert.True(t, found)
+	assert.Less(t, requested, 40)
+
+	index, found = search.ExponentialSearchUnbounded(squares, 1_000_001, less)
+	assert.Equal(t, 1001, index)
+	assert.False(t, found)
+
+	// A finite sequence ends at the first index at returns false for.
+	short := func(i int) (int, bool) { return 2 * i, i < 5 }
+
+	index, found = search.ExponentialSearchUnbounded(short, 6, less)
+	assert.Equal(t, 3, index)
+	assert.True(t, found)
+
+	index, found = search.ExponentialSearchUnbounded(short, 100, less)
+	assert.Equal(t, 5, index)
+	assert.False(t, found)
+}
+
+func TestSearchUnbounded(t *testing.T) {
+	tests := []testSearchUnbounded{
+		{testName: "True from zero", pred: func(int) bool { return true }, expected: 0, expectedFound: true},
+		{testName: "True from one", pred: func(i int) bool { return i >= 1 }, expected: 1, expectedFound: true},
+		{testName: "True from a large index", pred: func(i int) bool { return i >= 123_456_789 }, expected: 123_456_789, expectedFound: true},
+		{testName: "True only at the largest index", pred: func(i int) bool { return i == math.MaxInt }, expected: math.MaxInt, expectedFound: true},
+		{testName: "Never true", pred: func(int) bool { return false }, expected: math.MaxInt, expectedFound: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			index, found := search.SearchUnbounded(test.pred)
+
+			assert.Equal(t, test.expected, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
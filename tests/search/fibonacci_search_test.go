@@ -0,0 +1,60 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFibonacciSearch(t *testing.T) {
+	data := []int{1, 3, 3, 3, 5, 8, 8, 13}
+
+	tests := []testBounds{
+		{testName: "Empty slice", data: nil, target: 4, expectedLowerBound: 0},
+		{testName: "Single element", data: []int{4}, target: 4, expectedLowerBound: 0, expectedFound: true},
+		{testName: "Single element, target greater", data: []int{4}, target: 5, expectedLowerBound: 1},
+		{testName: "Before all elements", data: data, target: 0, expectedLowerBound: 0},
+		{testName: "Run of duplicates", data: data, target: 3, expectedLowerBound: 1, expectedFound: true},
+		{testName: "Missing element", data: data, target: 6, expectedLowerBound: 5},
+		{testName: "Last element", data: data, target: 13, expectedLowerBound: 7, expectedFound: true},
+		{testName: "After all elements", data: data, target: 20, expectedLowerBound: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			index, found := search.FibonacciSearch(test.data, test.target)
+
+			assert.Equal(t, test.expectedLowerBound, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestFibonacciSearch_Property(t *testing.T) {
+	assertMatchesBinarySearch(t, search.FibonacciSearch[int16])
+}
+
+func TestFibonacciSearchFunc_AllPositions(t *testing.T) {
+	for n := 0; n <= 100; n++ {
+		data := make([]int, n)
+		for i := range data {
+			data[i] = 2 * i
+		}
+
+		for target := -1; target <= 2*n; target++ {
+			comparisons := 0
+			less := func(a, b int) bool {
+				comparisons++
+				return a < b
+			}
+
+			index, found := search.FibonacciSearchFunc(data, target, less)
+
+			assert.Equal(t, (target+1)/2, index)
+			assert.Equal(t, target >= 0 && target%2 == 0 && target < 2*n, found)
+			// About 1.44*log2(n+1) probes and the final check.
+			assert.LessOrEqual(t, comparisons, 11)
+		}
+	}
+}
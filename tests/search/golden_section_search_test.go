@@ -0,0 +1,157 @@
+package search_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testUnimodalSearch struct {
+	testName      string
+	lo, hi        float64
+	f             func(x float64) float64
+	tolerance     float64
+	goal          search.Goal
+	expectedArg   float64
+	expectedValue float64
+	delta         float64
+}
+
+// unimodalSearchTests are shared by the golden-section and Brent search tests.
+var unimodalSearchTests = []testUnimodalSearch{
+	{
+		testName:      "Minimum of a parabola",
+		lo:            0,
+		hi:            5,
+		f:             func(x float64) float64 { return (x-2)*(x-2) + 1 },
+		tolerance:     1e-9,
+		goal:          search.Minimize,
+		expectedArg:   2,
+		expectedValue: 1,
+		delta:         1e-7,
+	},
+	{
+		testName:      "Maximum of sine",
+		lo:            0,
+		hi:            math.Pi,
+		f:             math.Sin,
+		tolerance:     1e-9,
+		goal:          search.Maximize,
+		expectedArg:   math.Pi / 2,
+		expectedValue: 1,
+		delta:         1e-7,
+	},
+	{
+		testName:      "Non-smooth minimum",
+		lo:            -3,
+		hi:            10,
+		f:             func(x float64) float64 { return math.Abs(x - 1.25) },
+		tolerance:     1e-9,
+		goal:          search.Minimize,
+		expectedArg:   1.25,
+		expectedValue: 0,
+		delta:         1e-7,
+	},
+	{
+		testName:      "Minimum at the boundary",
+		lo:            1,
+		hi:            4,
+		f:             math.Exp,
+		tolerance:     1e-9,
+		goal:          search.Minimize,
+		expectedArg:   1,
+		expectedValue: math.E,
+		delta:         1e-7,
+	},
+	{
+		testName:      "Zero tolerance",
+		lo:            -1,
+		hi:            1,
+		f:             func(x float64) float64 { return math.Cosh(x - 0.3) },
+		tolerance:     0,
+		goal:          search.Minimize,
+		expectedArg:   0.3,
+		expectedValue: 1,
+		delta:         1e-7,
+	},
+	{
+		testName:      "Degenerate interval",
+		lo:            3,
+		hi:            3,
+		f:             func(x float64) float64 { return x * x },
+		tolerance:     1e-9,
+		goal:          search.Minimize,
+		expectedArg:   3,
+		expectedValue: 9,
+		delta:         0,
+	},
+}
+
+func TestGoldenSectionSearch(t *testing.T) {
+	for _, test := range unimodalSearchTests {
+		t.Run(test.testName, func(t *testing.T) {
+			calls := 0
+			f := func(x float64) float64 {
+				calls++
+				return test.f(x)
+			}
+
+			optimum, err := search.GoldenSectionSearch(test.lo, test.hi, f, test.tolerance, 0, test.goal)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expectedArg, optimum.Arg, test.delta)
+			assert.InDelta(t, test.expectedValue, optimum.Value, test.delta)
+			assert.Equal(t, calls, optimum.Evaluations)
+		})
+	}
+}
+
+func TestGoldenSectionSearch_MaxIterations(t *testing.T) {
+	f := func(x float64) float64 { return (x - 0.7) * (x - 0.7) }
+
+	optimum, err := search.GoldenSectionSearch(0, 1, f, 0, 10, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, optimum.Evaluations)
+	// Ten steps shrink the interval to 0.618^10 of its length.
+	assert.InDelta(t, 0.7, optimum.Arg, math.Pow(0.618034, 10))
+}
+
+func TestGoldenSectionSearch_Float32(t *testing.T) {
+	f := func(x float32) float32 { return (x + 1.5) * (x + 1.5) }
+
+	optimum, err := search.GoldenSectionSearch(float32(-4), float32(4), f, 0, 0, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.InDelta(t, -1.5, float64(optimum.Arg), 1e-3)
+}
+
+func TestGoldenSectionSearch_NearMaximumInterval(t *testing.T) {
+	f := func(x float64) float64 { return math.Abs(x - 1e300) }
+
+	optimum, err := search.GoldenSectionSearch(-math.MaxFloat64/2, math.MaxFloat64/2, f, 0, 0, search.Minimize)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 1e300, optimum.Arg, 1e-12)
+	assert.Equal(t, f(optimum.Arg), optimum.Value)
+}
+
+func TestGoldenSectionSearch_InvalidArguments(t *testing.T) {
+	f := func(x float64) float64 { return x }
+
+	_, err := search.GoldenSectionSearch(2, 1, f, 0, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.GoldenSectionSearch(math.Inf(-1), 1, f, 0, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.GoldenSectionSearch(-math.MaxFloat64, math.MaxFloat64, f, 0, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+
+	_, err = search.GoldenSectionSearch(0, 1, f, -1, 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidTolerance)
+
+	_, err = search.GoldenSectionSearch(0, 1, f, math.NaN(), 0, search.Minimize)
+	assert.ErrorIs(t, err, search.ErrInvalidTolerance)
+}
@@ -0,0 +1,118 @@
+package search_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpolationSearch(t *testing.T) {
+	data := []int{1, 3, 3, 3, 5, 8, 8, 13}
+
+	tests := []testBounds{
+		{testName: "Empty slice", data: nil, target: 4, expectedLowerBound: 0},
+		{testName: "Single element", data: []int{4}, target: 4, expectedLowerBound: 0, expectedFound: true},
+		{testName: "All elements equal", data: []int{2, 2, 2, 2}, target: 2, expectedLowerBound: 0, expectedFound: true},
+		{testName: "Before all elements", data: data, target: 0, expectedLowerBound: 0},
+		{testName: "Run of duplicates", data: data, target: 3, expectedLowerBound: 1, expectedFound: true},
+		{testName: "Missing element", data: data, target: 6, expectedLowerBound: 5},
+		{testName: "Last element", data: data, target: 13, expectedLowerBound: 7, expectedFound: true},
+		{testName: "After all elements", data: data, target: 20, expectedLowerBound: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			index, found := search.InterpolationSearch(test.data, test.target)
+
+			assert.Equal(t, test.expectedLowerBound, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestInterpolationSearch_Property(t *testing.T) {
+	assertMatchesBinarySearch(t, search.InterpolationSearch[int16])
+}
+
+func TestInterpolationSearch_ExtremeValues(t *testing.T) {
+	ints := []int64{math.MinInt64, -1, 0, 1, math.MaxInt64}
+	for i, target := range ints {
+		index, found := search.InterpolationSearch(ints, target)
+
+		assert.Equal(t, i, index)
+		assert.True(t, found)
+	}
+
+	unsigned := []uint8{0, 10, 200, 255}
+	index, found := search.InterpolationSearch(unsigned, 199)
+	assert.Equal(t, 2, index)
+	assert.False(t, found)
+
+	floats := []float64{math.NaN(), math.Inf(-1), -2.5, 0, 1e300, math.Inf(1)}
+	for i, target := range floats {
+		index, found := search.InterpolationSearch(floats, target)
+
+		assert.Equal(t, i, index)
+		assert.True(t, found)
+	}
+
+	index, found = search.InterpolationSearch(floats, 1)
+	assert.Equal(t, 4, index)
+	assert.False(t, found)
+}
+
+func TestInterpolationSearchBy_SkewedKeysFallBack(t *testing.T) {
+	type entry struct {
+		key   float64
+		label string
+	}
+
+	// Exponentially growing keys make every interpolated probe land next to the lower end.
+	data := make([]entry, 1<<16)
+	for i := range data {
+		data[i] = entry{key: math.Pow(1.001, float64(i)), label: "x"}
+	}
+
+	probes := 0
+	key := func(e entry) float64 {
+		probes++
+		return e.key
+	}
+
+	for _, i := range []int{0, 1, 100, 30_000, len(data) - 1} {
+		probes = 0
+		index, found := search.InterpolationSearchBy(data, data[i].key, key)
+
+		assert.Equal(t, i, index)
+		assert.True(t, found)
+		// Three key reads per probe, at most twice as many probes as a binary search.
+		assert.LessOrEqual(t, probes, 3*2*17+3)
+	}
+}
+
+func TestInterpolationSearch_UniformKeysNeedFewProbes(t *testing.T) {
+	data := make([]int, 1<<20)
+	for i := range data {
+		data[i] = 7 * i
+	}
+
+	probes := 0
+	key := func(value int) int {
+		probes++
+		return value
+	}
+
+	targets := []int{0, 7 * 12345, 7*777_777 + 3, 7 * (len(data) - 1)}
+	for _, target := range targets {
+		probes = 0
+		index, found := search.InterpolationSearchBy(data, target, key)
+		expectedIndex, expectedFound := slices.BinarySearch(data, target)
+
+		assert.Equal(t, expectedIndex, index)
+		assert.Equal(t, expectedFound, found)
+		assert.LessOrEqual(t, probes, 3*4)
+	}
+}
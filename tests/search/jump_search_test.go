@@ -0,0 +1,56 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJumpSearch(t *testing.T) {
+	data := []int{1, 3, 3, 3, 5, 8, 8, 13, 21}
+
+	tests := []testBounds{
+		{testName: "Empty slice", data: nil, target: 4, expectedLowerBound: 0},
+		{testName: "Single element", data: []int{4}, target: 4, expectedLowerBound: 0, expectedFound: true},
+		{testName: "Before all elements", data: data, target: 0, expectedLowerBound: 0},
+		{testName: "Run of duplicates across blocks", data: data, target: 3, expectedLowerBound: 1, expectedFound: true},
+		{testName: "Missing element", data: data, target: 6, expectedLowerBound: 5},
+		{testName: "End of a block", data: data, target: 8, expectedLowerBound: 5, expectedFound: true},
+		{testName: "Last element", data: data, target: 21, expectedLowerBound: 8, expectedFound: true},
+		{testName: "After all elements", data: data, target: 30, expectedLowerBound: 9},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			index, found := search.JumpSearch(test.data, test.target)
+
+			assert.Equal(t, test.expectedLowerBound, index)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestJumpSearch_Property(t *testing.T) {
+	assertMatchesBinarySearch(t, search.JumpSearch[int16])
+}
+
+func TestJumpSearchFunc_Comparisons(t *testing.T) {
+	data := make([]int, 10_000)
+	for i := range data {
+		data[i] = i
+	}
+
+	comparisons := 0
+	less := func(a, b int) bool {
+		comparisons++
+		return a < b
+	}
+
+	index, found := search.JumpSearchFunc(data, 9_998, less)
+
+	assert.Equal(t, 9_998, index)
+	assert.True(t, found)
+	// At most sqrt(n) jumps, sqrt(n) steps inside the block and the final check.
+	assert.LessOrEqual(t, comparisons, 2*100+2)
+}
@@ -0,0 +1,40 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPrefixFunction struct {
+	testName string
+	s        string
+	expected []int
+}
+
+func TestPrefixFunction(t *testing.T) {
+	tests := []testPrefixFunction{
+		{testName: "Empty string", s: "", expected: []int{}},
+		{testName: "Single byte", s: "a", expected: []int{0}},
+		{testName: "No borders", s: "abcd", expected: []int{0, 0, 0, 0}},
+		{testName: "Repeated byte", s: "aaaa", expected: []int{0, 1, 2, 3}},
+		{testName: "Fallback to a shorter border", s: "aabaaab", expected: []int{0, 1, 0, 1, 2, 2, 3}},
+		{testName: "Classic example", s: "ABCDABD", expected: []int{0, 0, 0, 0, 1, 2, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expected, search.PrefixFunction(test.s))
+			assert.Equal(t, test.expected, search.PrefixFunction([]byte(test.s)))
+		})
+	}
+}
+
+func TestKMP_Compiled(t *testing.T) {
+	k := search.NewKMP("abab")
+
+	assert.Equal(t, []byte("abab"), k.Pattern)
+	assert.Equal(t, []int{0, 0, 1, 2}, k.Prefix)
+	assert.Equal(t, []int{0, 2, 4}, k.FindAllString("abababab"))
+}
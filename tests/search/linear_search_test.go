@@ -1 +1,231 @@
 package search_test
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/queues"
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLinearSearch struct {
+	testName          string
+	data              []int
+	target            int
+	expectedIndex     int
+	expectedLastIndex int
+	expectedAll       []int
+}
+
+type testParallelIndex struct {
+	testName string
+	pred     func(value int) bool
+	expected int
+}
+
+type testSequence struct {
+	testName string
+	seq      func(yield func(int) bool)
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestLinearSearch(t *testing.T) {
+	tests := []testLinearSearch{
+		{testName: "Empty slice", data: nil, target: 1, expectedIndex: -1, expectedLastIndex: -1},
+		{testName: "Single match", data: []int{7}, target: 7, expectedIndex: 0, expectedLastIndex: 0, expectedAll: []int{0}},
+		{testName: "Single mismatch", data: []int{7}, target: 8, expectedIndex: -1, expectedLastIndex: -1},
+		{testName: "Match in the middle", data: []int{4, 1, 9, 3}, target: 9, expectedIndex: 2, expectedLastIndex: 2, expectedAll: []int{2}},
+		{testName: "Match at the end only", data: []int{4, 1, 9, 3}, target: 3, expectedIndex: 3, expectedLastIndex: 3, expectedAll: []int{3}},
+		{testName: "Several matches", data: []int{5, 2, 5, 5, 0, 5}, target: 5, expectedIndex: 0, expectedLastIndex: 5, expectedAll: []int{0, 2, 3, 5}},
+		{testName: "No match", data: []int{1, 2, 3, 4}, target: 5, expectedIndex: -1, expectedLastIndex: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			equal := func(value int) bool { return value == test.target }
+			original := append([]int(nil), test.data...)
+
+			assert.Equal(t, test.expectedIndex, search.Index(test.data, test.target))
+			assert.Equal(t, test.expectedIndex, search.IndexFunc(test.data, equal))
+			assert.Equal(t, test.expectedIndex, search.IndexSentinel(test.data, test.target))
+			assert.Equal(t, original, test.data)
+
+			assert.Equal(t, test.expectedLastIndex, search.LastIndex(test.data, test.target))
+			assert.Equal(t, test.expectedLastIndex, search.LastIndexFunc(test.data, equal))
+
+			assert.Equal(t, test.expectedAll, search.FindAll(test.data, equal))
+			assert.Equal(t, len(test.expectedAll), search.CountIf(test.data, equal))
+		})
+	}
+}
+
+func TestIndexSentinel_NaN(t *testing.T) {
+	data := []float64{1, math.NaN(), 3}
+
+	assert.Equal(t, -1, search.IndexSentinel(data, math.NaN()))
+	assert.Equal(t, 2, search.IndexSentinel(data, 3))
+	assert.Equal(t, 3.0, data[2])
+}
+
+func TestLinearSearch_Sequences(t *testing.T) {
+	values := []int{3, 8, 5, 6, 1, 4, 7}
+
+	sll := linked_lists.NewSinglyLinkedList[int](nil)
+	dll := linked_lists.NewDoublyLinkedList[int](nil)
+	ull := linked_lists.NewUnrolledLinkedList[int](3, nil)
+	queue := queues.NewQueue[int](nil)
+	deque := queues.NewDeque[int](nil)
+
+	for _, value := range values {
+		sll.InsertAtEnd(value)
+		dll.InsertAtEnd(value)
+		ull.InsertAtEnd(value)
+		queue.Push(value)
+		deque.PushAtEnd(value)
+	}
+
+	tests := []testSequence{
+		{testName: "Singly linked list", seq: sll.All()},
+		{testName: "Doubly linked list", seq: dll.All()},
+		{testName: "Unrolled linked list", seq: ull.All()},
+		{testName: "Persistent list", seq: linked_lists.NewList(values...).All()},
+		{testName: "Queue", seq: queue.All()},
+		{testName: "Deque", seq: deque.All()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, 1, search.IndexSeq(test.seq, isEven))
+			assert.Equal(t, 5, search.LastIndexSeq(test.seq, isEven))
+			assert.Equal(t, []int{1, 3, 5}, search.FindAllSeq(test.seq, isEven))
+			assert.Equal(t, 3, search.CountIfSeq(test.seq, isEven))
+			assert.Equal(t, -1, search.IndexSeq(test.seq, func(value int) bool { return value > 10 }))
+		})
+	}
+}
+
+func TestIndexSeq_StopsAtFirstMatch(t *testing.T) {
+	visited := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; i < 100; i++ {
+			visited++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	assert.Equal(t, 10, search.IndexSeq(seq, func(value int) bool { return value == 10 }))
+	assert.Equal(t, 11, visited)
+}
+
+func TestLinearSearch_PriorityQueue(t *testing.T) {
+	pq := queues.NewPriorityQueue([]int{5, 9, 2, 7}, func(a, b int) bool { return a > b }, func(a, b int) bool { return a == b })
+
+	assert.Equal(t, 0, search.IndexSeq(pq.All(), func(value int) bool { return value == 9 }))
+	assert.Equal(t, 2, search.CountIfSeq(pq.All(), func(value int) bool { return value > 5 }))
+}
+
+func TestParallelIndexFunc(t *testing.T) {
+	data := make([]int, 100_000)
+	for i := range data {
+		data[i] = i % 1000
+	}
+
+	tests := []testParallelIndex{
+		{testName: "Match in the first chunk", pred: func(value int) bool { return value == 17 }, expected: 17},
+		{testName: "First of many matches across chunks", pred: func(value int) bool { return value == 999 }, expected: 999},
+		{testName: "No match", pred: func(value int) bool { return value < 0 }, expected: -1},
+	}
+
+	for _, test := range tests {
+		for _, workers := range []int{0, 1, 3, 8} {
+			t.Run(test.testName, func(t *testing.T) {
+				index, err := search.ParallelIndexFunc(context.Background(), data, test.pred, workers)
+
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, index)
+			})
+		}
+	}
+
+	t.Run("Late match", func(t *testing.T) {
+		late := make([]int, 50_000)
+		late[len(late)-2] = 1
+
+		index, err := search.ParallelIndexFunc(context.Background(), late, func(value int) bool { return value == 1 }, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(late)-2, index)
+	})
+
+	t.Run("Empty slice", func(t *testing.T) {
+		index, err := search.ParallelIndexFunc(context.Background(), []int(nil), isEven, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, -1, index)
+	})
+}
+
+func TestParallelIndexFunc_StopsEarly(t *testing.T) {
+	data := make([]int, 1_000_000)
+	data[10] = 1
+
+	var calls atomic.Int64
+	pred := func(value int) bool {
+		calls.Add(1)
+		return value == 1
+	}
+
+	index, err := search.ParallelIndexFunc(context.Background(), data, pred, 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, index)
+	assert.Less(t, calls.Load(), int64(len(data)/10))
+}
+
+func TestParallelIndexFunc_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	index, err := search.ParallelIndexFunc(ctx, make([]int, 100_000), isEven, 4)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, -1, index)
+}
+
+func BenchmarkLinearSearch(b *testing.B) {
+	data := make([]int, 1<<16)
+	for i := range data {
+		data[i] = i
+	}
+
+	target := len(data) - 1
+
+	b.Run("Index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			search.Index(data, target)
+		}
+	})
+
+	b.Run("IndexSentinel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			search.IndexSentinel(data, target)
+		}
+	})
+
+	b.Run("ParallelIndexFunc", func(b *testing.B) {
+		pred := func(value int) bool { return value == target }
+
+		for i := 0; i < b.N; i++ {
+			_, _ = search.ParallelIndexFunc(context.Background(), data, pred, 0)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package search_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRabinKarpMulti struct {
+	testName string
+	text     string
+	patterns []string
+	expected []search.Match
+}
+
+func TestRabinKarpSearchAll(t *testing.T) {
+	tests := []testRabinKarpMulti{
+		{
+			testName: "No patterns",
+			text:     "abc",
+			patterns: nil,
+			expected: nil,
+		},
+		{
+			testName: "Patterns of different lengths",
+			text:     "she sells sea shells",
+			patterns: []string{"he", "she", "sea", "hell"},
+			expected: []search.Match{
+				{Pos: 0, Pattern: 1},
+				{Pos: 1, Pattern: 0},
+				{Pos: 10, Pattern: 2},
+				{Pos: 14, Pattern: 1},
+				{Pos: 15, Pattern: 0},
+				{Pos: 15, Pattern: 3},
+			},
+		},
+		{
+			testName: "Same position is ordered by pattern index",
+			text:     "abcd",
+			patterns: []string{"abcd", "abc", "ab", "bc"},
+			expected: []search.Match{
+				{Pos: 0, Pattern: 0},
+				{Pos: 0, Pattern: 1},
+				{Pos: 0, Pattern: 2},
+				{Pos: 1, Pattern: 3},
+			},
+		},
+		{
+			testName: "Duplicate and empty patterns",
+			text:     "aXa",
+			patterns: []string{"a", "", "a", "Y"},
+			expected: []search.Match{
+				{Pos: 0, Pattern: 0},
+				{Pos: 0, Pattern: 2},
+				{Pos: 2, Pattern: 0},
+				{Pos: 2, Pattern: 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			rk := search.NewRabinKarp(test.patterns...)
+
+			assert.Equal(t, test.expected, search.RabinKarpSearchAll(test.text, test.patterns...))
+			assert.Equal(t, test.expected, rk.FindAll([]byte(test.text)))
+			assert.Equal(t, test.expected, rk.FindAllString(test.text))
+
+			var streamed []search.Match
+			err := rk.FindReader(strings.NewReader(test.text), func(pos int64, pattern int) bool {
+				streamed = append(streamed, search.Match{Pos: int(pos), Pattern: pattern})
+				return true
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, streamed)
+		})
+	}
+}
+
+func TestRabinKarp_ReaderMultiPatternAcrossBuffers(t *testing.T) {
+	patterns := []string{"ERROR", "connection refused", "timeout"}
+
+	var text strings.Builder
+	for text.Len() < 3*search.DefaultStreamBufferSize {
+		text.WriteString("INFO ok; ERROR: connection refused; WARN timeout; ")
+	}
+
+	expected := search.RabinKarpSearchAll(text.String(), patterns...)
+
+	var streamed []search.Match
+	err := search.NewRabinKarp(patterns...).FindReader(&chunkedReader{data: []byte(text.String()), chunk: 4096}, func(pos int64, pattern int) bool {
+		streamed = append(streamed, search.Match{Pos: int(pos), Pattern: pattern})
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, streamed)
+
+	for i, pattern := range patterns {
+		var positions []int
+		for _, match := range expected {
+			if match.Pattern == i {
+				positions = append(positions, match.Pos)
+			}
+		}
+
+		assert.Equal(t, naiveSearch(text.String(), pattern), positions)
+	}
+}
+
+func TestRabinKarp_ReaderMixedLengthsAcrossBuffers(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+
+	for _, size := range []int{3 * search.DefaultStreamBufferSize, 3*search.DefaultStreamBufferSize + 17} {
+		text := make([]byte, size)
+		for i := range text {
+			text[i] = "ab"[rng.Intn(2)]
+		}
+
+		// A long pattern taken from the text right before a buffer boundary.
+		long := string(text[2*search.DefaultStreamBufferSize-30 : 2*search.DefaultStreamBufferSize+10])
+		patterns := []string{"ab", "bba", long, "a"}
+
+		expected := search.RabinKarpSearchAll(string(text), patterns...)
+
+		var streamed []search.Match
+		err := search.NewRabinKarp(patterns...).FindReader(&chunkedReader{data: text, chunk: 1}, func(pos int64, pattern int) bool {
+			streamed = append(streamed, search.Match{Pos: int(pos), Pattern: pattern})
+			return true
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, streamed)
+		assert.Contains(t, streamed, search.Match{Pos: 2*search.DefaultStreamBufferSize - 30, Pattern: 2})
+	}
+}
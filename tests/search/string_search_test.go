@@ -0,0 +1,379 @@
+package search_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStringSearch struct {
+	testName string
+	text     string
+	pattern  string
+	expected []int
+}
+
+// streamMatcher is implemented by the single-pattern matchers of the search package.
+type streamMatcher interface {
+	FindAll(text []byte) []int
+	FindAllString(text string) []int
+	FindReader(r io.Reader, yield func(pos int64) bool) error
+}
+
+type testStringMatcher struct {
+	testName   string
+	search     func(text, pattern string) []int
+	newMatcher func(pattern string) streamMatcher
+}
+
+// stringMatchers lists every single-pattern algorithm, so that they are all checked against the same cases.
+var stringMatchers = []testStringMatcher{
+	{
+		testName:   "KMP",
+		search:     search.KMPSearch[string],
+		newMatcher: func(pattern string) streamMatcher { return search.NewKMP(pattern) },
+	},
+	{
+		testName:   "BoyerMoore",
+		search:     search.BoyerMooreSearch[string],
+		newMatcher: func(pattern string) streamMatcher { return search.NewBoyerMoore(pattern) },
+	},
+	{
+		testName:   "RabinKarp",
+		search:     search.RabinKarpSearch[string],
+		newMatcher: func(pattern string) streamMatcher { return rabinKarpMatcher{search.NewRabinKarp(pattern)} },
+	},
+	{
+		testName:   "Z",
+		search:     search.ZSearch[string],
+		newMatcher: func(pattern string) streamMatcher { return search.NewZAlgorithm(pattern) },
+	},
+}
+
+// rabinKarpMatcher adapts a single-pattern RabinKarp to streamMatcher.
+type rabinKarpMatcher struct {
+	rk *search.RabinKarp
+}
+
+func (m rabinKarpMatcher) FindAll(text []byte) []int {
+	return matchPositions(m.rk.FindAll(text))
+}
+
+func (m rabinKarpMatcher) FindAllString(text string) []int {
+	return matchPositions(m.rk.FindAllString(text))
+}
+
+func (m rabinKarpMatcher) FindReader(r io.Reader, yield func(pos int64) bool) error {
+	return m.rk.FindReader(r, func(pos int64, _ int) bool { return yield(pos) })
+}
+
+func matchPositions(matches []search.Match) []int {
+	var positions []int
+	for _, match := range matches {
+		positions = append(positions, match.Pos)
+	}
+
+	return positions
+}
+
+// naiveSearch is the reference implementation: it compares the pattern at every position.
+func naiveSearch(text, pattern string) []int {
+	var positions []int
+
+	if pattern == "" {
+		return nil
+	}
+
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if text[i:i+len(pattern)] == pattern {
+			positions = append(positions, i)
+		}
+	}
+
+	return positions
+}
+
+// readerPositions collects the offsets reported by FindReader.
+func readerPositions(t *testing.T, m streamMatcher, r io.Reader) []int {
+	t.Helper()
+
+	var positions []int
+	err := m.FindReader(r, func(pos int64) bool {
+		positions = append(positions, int(pos))
+		return true
+	})
+	assert.NoError(t, err)
+
+	return positions
+}
+
+func TestStringSearch(t *testing.T) {
+	tests := []testStringSearch{
+		{testName: "Empty text", text: "", pattern: "abc", expected: nil},
+		{testName: "Empty pattern", text: "abc", pattern: "", expected: nil},
+		{testName: "Pattern longer than text", text: "ab", pattern: "abc", expected: nil},
+		{testName: "Whole text", text: "abc", pattern: "abc", expected: []int{0}},
+		{testName: "Single byte", text: "banana", pattern: "a", expected: []int{1, 3, 5}},
+		{testName: "Overlapping occurrences", text: "aaaaa", pattern: "aa", expected: []int{0, 1, 2, 3}},
+		{testName: "Periodic pattern", text: "abababcabababab", pattern: "abab", expected: []int{0, 2, 7, 9, 11}},
+		{testName: "No occurrence", text: "the quick brown fox", pattern: "cat", expected: nil},
+		{testName: "Occurrence at the end", text: "log: error", pattern: "error", expected: []int{5}},
+		{testName: "Good suffix shift", text: "ABAAABCDABCABCDABCDABDE", pattern: "ABCDABD", expected: []int{15}},
+		{testName: "Binary data", text: "\x00\xff\x00\xff\x00", pattern: "\x00\xff\x00", expected: []int{0, 2}},
+		{testName: "UTF-8 text", text: "привет, мир, привет", pattern: "привет", expected: []int{0, 22}},
+	}
+
+	for _, matcher := range stringMatchers {
+		for _, test := range tests {
+			t.Run(matcher.testName+"/"+test.testName, func(t *testing.T) {
+				m := matcher.newMatcher(test.pattern)
+
+				assert.Equal(t, test.expected, matcher.search(test.text, test.pattern))
+				assert.Equal(t, test.expected, m.FindAll([]byte(test.text)))
+				assert.Equal(t, test.expected, m.FindAllString(test.text))
+				assert.Equal(t, test.expected, readerPositions(t, m, strings.NewReader(test.text)))
+			})
+		}
+	}
+}
+
+func TestStringSearch_Bytes(t *testing.T) {
+	text, pattern := []byte("abracadabra"), []byte("abra")
+	expected := []int{0, 7}
+
+	assert.Equal(t, expected, search.KMPSearch(text, pattern))
+	assert.Equal(t, expected, search.BoyerMooreSearch(text, pattern))
+	assert.Equal(t, expected, search.RabinKarpSearch(text, pattern))
+	assert.Equal(t, expected, search.ZSearch(text, pattern))
+}
+
+func TestStringSearch_PatternIsCopied(t *testing.T) {
+	pattern := []byte("abc")
+	m := search.NewKMP(pattern)
+	pattern[0] = 'x'
+
+	assert.Equal(t, []int{1}, m.FindAllString("xabc"))
+}
+
+func TestStringSearch_RandomAgainstNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	randomString := func(n int, alphabet string) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+
+		return string(b)
+	}
+
+	for _, matcher := range stringMatchers {
+		t.Run(matcher.testName, func(t *testing.T) {
+			for i := 0; i < 500; i++ {
+				alphabet := "ab"
+				if i%2 == 1 {
+					alphabet = "abcd"
+				}
+
+				text := randomString(rng.Intn(200), alphabet)
+				pattern := randomString(1+rng.Intn(6), alphabet)
+
+				assert.Equal(t, naiveSearch(text, pattern), matcher.search(text, pattern), "text %q, pattern %q", text, pattern)
+			}
+		})
+	}
+}
+
+func TestStringSearch_ReaderAcrossBuffers(t *testing.T) {
+	pattern := "needle-in-the-haystack"
+
+	// Occurrences straddle every buffer boundary, one byte further into it each time.
+	var text strings.Builder
+	for i := 1; i <= 5; i++ {
+		text.WriteString(strings.Repeat(".", i*search.DefaultStreamBufferSize-text.Len()-i))
+		text.WriteString(pattern)
+	}
+	text.WriteString(pattern)
+
+	expected := naiveSearch(text.String(), pattern)
+	assert.Len(t, expected, 6)
+
+	for _, matcher := range stringMatchers {
+		t.Run(matcher.testName, func(t *testing.T) {
+			m := matcher.newMatcher(pattern)
+
+			assert.Equal(t, expected, readerPositions(t, m, strings.NewReader(text.String())))
+			// A reader that returns a few bytes at a time must give the same result.
+			assert.Equal(t, expected, readerPositions(t, m, &chunkedReader{data: []byte(text.String()), chunk: 1000}))
+		})
+	}
+}
+
+func TestStringSearch_ReaderRandomText(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	text := make([]byte, 5*search.DefaultStreamBufferSize+123)
+	for i := range text {
+		text[i] = "ab"[rng.Intn(2)]
+	}
+
+	for _, pattern := range []string{"a", "abba", "babbabab", "aabbbabaababbb"} {
+		expected := naiveSearch(string(text), pattern)
+
+		for _, matcher := range stringMatchers {
+			t.Run(matcher.testName+"/"+pattern, func(t *testing.T) {
+				assert.Equal(t, expected, readerPositions(t, matcher.newMatcher(pattern), bytes.NewReader(text)))
+			})
+		}
+	}
+}
+
+func TestStringSearch_ReaderLongPattern(t *testing.T) {
+	pattern := strings.Repeat("xy", search.DefaultStreamBufferSize)
+	text := "z" + pattern + "x" + pattern
+
+	for _, matcher := range stringMatchers {
+		t.Run(matcher.testName, func(t *testing.T) {
+			positions := readerPositions(t, matcher.newMatcher(pattern), strings.NewReader(text))
+
+			assert.Equal(t, []int{1, 2 + len(pattern)}, positions)
+		})
+	}
+}
+
+func TestStringSearch_ReaderStopsAndFails(t *testing.T) {
+	text := strings.Repeat("ab", 1000)
+
+	for _, matcher := range stringMatchers {
+		t.Run(matcher.testName, func(t *testing.T) {
+			m := matcher.newMatcher("ab")
+
+			var positions []int64
+			err := m.FindReader(strings.NewReader(text), func(pos int64) bool {
+				positions = append(positions, pos)
+				return len(positions) < 3
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{0, 2, 4}, positions)
+
+			readErr := errors.New("disk failure")
+			err = m.FindReader(io.MultiReader(strings.NewReader(text), &failingReader{err: readErr}), func(int64) bool { return true })
+
+			assert.ErrorIs(t, err, readErr)
+		})
+	}
+}
+
+// chunkedReader returns at most chunk bytes per Read.
+type chunkedReader struct {
+	data  []byte
+	chunk int
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(p[:min(len(p), r.chunk)], r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+// failingReader fails every Read with err.
+type failingReader struct {
+	err error
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func FuzzStringSearch(f *testing.F) {
+	f.Add([]byte("abababcabababab"), []byte("abab"))
+	f.Add([]byte("aaaaa"), []byte("aa"))
+	f.Add([]byte("ABAAABCDABCABCDABCDABDE"), []byte("ABCDABD"))
+
+	f.Fuzz(func(t *testing.T, text, pattern []byte) {
+		expected := naiveSearch(string(text), string(pattern))
+
+		for _, matcher := range stringMatchers {
+			m := matcher.newMatcher(string(pattern))
+
+			if got := m.FindAll(text); !equalInts(expected, got) {
+				t.Fatalf("%s: text %q, pattern %q: got %v, expected %v", matcher.testName, text, pattern, got, expected)
+			}
+
+			if got := readerPositions(t, m, bytes.NewReader(text)); !equalInts(expected, got) {
+				t.Fatalf("%s reader: text %q, pattern %q: got %v, expected %v", matcher.testName, text, pattern, got, expected)
+			}
+		}
+	})
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func BenchmarkStringSearch(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+
+	words := []string{"INFO", "DEBUG", "request", "served", "in", "ms", "user", "id", "GET", "/api/v1/items"}
+
+	var log strings.Builder
+	for log.Len() < 1<<20 {
+		log.WriteString(words[rng.Intn(len(words))])
+		log.WriteByte(' ')
+	}
+
+	text := []byte(log.String())
+
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{name: "Short", pattern: "ERROR"},
+		{name: "Long", pattern: "connection reset by peer while reading response header"},
+	}
+
+	for _, p := range patterns {
+		for _, matcher := range stringMatchers {
+			m := matcher.newMatcher(p.pattern)
+
+			b.Run(p.name+"/"+matcher.testName, func(b *testing.B) {
+				b.SetBytes(int64(len(text)))
+
+				for i := 0; i < b.N; i++ {
+					_ = m.FindAll(text)
+				}
+			})
+		}
+
+		b.Run(p.name+"/bytes.Index", func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			pattern := []byte(p.pattern)
+
+			for i := 0; i < b.N; i++ {
+				_ = bytes.Index(text, pattern)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package search_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTernarySearch struct {
+	testName      string
+	lo, hi        int
+	f             func(x int) int
+	goal          search.Goal
+	expectedArg   int
+	expectedValue int
+}
+
+func TestTernarySearch(t *testing.T) {
+	tests := []testTernarySearch{
+		{
+			testName:      "Minimum of a parabola",
+			lo:            -100,
+			hi:            100,
+			f:             func(x int) int { return (x - 37) * (x - 37) },
+			goal:          search.Minimize,
+			expectedArg:   37,
+			expectedValue: 0,
+		},
+		{
+			testName:      "Maximum of a parabola",
+			lo:            -100,
+			hi:            100,
+			f:             func(x int) int { return 5 - (x+12)*(x+12) },
+			goal:          search.Maximize,
+			expectedArg:   -12,
+			expectedValue: 5,
+		},
+		{
+			testName:      "Minimum at the lower bound",
+			lo:            0,
+			hi:            1000,
+			f:             func(x int) int { return 3 * x },
+			goal:          search.Minimize,
+			expectedArg:   0,
+			expectedValue: 0,
+		},
+		{
+			testName:      "Maximum at the upper bound",
+			lo:            0,
+			hi:            1000,
+			f:             func(x int) int { return 3 * x },
+			goal:          search.Maximize,
+			expectedArg:   1000,
+			expectedValue: 3000,
+		},
+		{
+			testName:      "Single point",
+			lo:            7,
+			hi:            7,
+			f:             func(x int) int { return x * x },
+			goal:          search.Minimize,
+			expectedArg:   7,
+			expectedValue: 49,
+		},
+		{
+			testName:      "Two points",
+			lo:            7,
+			hi:            8,
+			f:             func(x int) int { return -x },
+			goal:          search.Minimize,
+			expectedArg:   8,
+			expectedValue: -8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			optimum, err := search.TernarySearch(test.lo, test.hi, test.f, test.goal)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedArg, optimum.Arg)
+			assert.Equal(t, test.expectedValue, optimum.Value)
+			assert.Positive(t, optimum.Evaluations)
+		})
+	}
+}
+
+func TestTernarySearch_FlatMinimum(t *testing.T) {
+	optimum, err := search.TernarySearch(-50, 50, func(x int) int { return max(x-10, 0, -x) }, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, optimum.Value)
+	assert.GreaterOrEqual(t, optimum.Arg, 0)
+	assert.LessOrEqual(t, optimum.Arg, 10)
+}
+
+func TestTernarySearch_Evaluations(t *testing.T) {
+	calls := 0
+	f := func(x int) float64 {
+		calls++
+		return math.Abs(float64(x) - 123_456.5)
+	}
+
+	optimum, err := search.TernarySearch(0, 1_000_000, f, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 123_456, optimum.Arg)
+	assert.Equal(t, calls, optimum.Evaluations)
+	// Two evaluations per step, log_1.5(10^6) < 35 steps, and at most three final points.
+	assert.LessOrEqual(t, optimum.Evaluations, 2*35+3)
+}
+
+func TestTernarySearch_WholeRangeOfType(t *testing.T) {
+	signed, err := search.TernarySearch(int8(math.MinInt8), int8(math.MaxInt8), func(x int8) int {
+		return (int(x) - 100) * (int(x) - 100)
+	}, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int8(100), signed.Arg)
+
+	unsigned, err := search.TernarySearch(uint64(0), uint64(math.MaxUint64), func(x uint64) uint64 {
+		if x > 1<<63 {
+			return x - 1<<63
+		}
+
+		return 1<<63 - x
+	}, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1<<63), unsigned.Arg)
+	assert.Equal(t, uint64(0), unsigned.Value)
+
+	wide, err := search.TernarySearch(int64(math.MinInt64), int64(math.MaxInt64), func(x int64) uint64 {
+		// The distance to 42, computed in uint64 so that it cannot overflow.
+		if x >= 42 {
+			return uint64(x) - 42
+		}
+
+		return 42 - uint64(x)
+	}, search.Minimize)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), wide.Arg)
+	assert.Equal(t, uint64(0), wide.Value)
+}
+
+func TestTernarySearch_InvalidInterval(t *testing.T) {
+	_, err := search.TernarySearch(5, 4, func(x int) int { return x }, search.Minimize)
+
+	assert.ErrorIs(t, err, search.ErrInvalidInterval)
+}
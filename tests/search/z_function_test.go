@@ -0,0 +1,47 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/search"
+	"github.com/stretchr/testify/assert"
+)
+
+type testZFunction struct {
+	testName string
+	s        string
+	expected []int
+}
+
+func TestZFunction(t *testing.T) {
+	tests := []testZFunction{
+		{testName: "Empty string", s: "", expected: []int{}},
+		{testName: "Single byte", s: "a", expected: []int{1}},
+		{testName: "Repeated byte", s: "aaaaa", expected: []int{5, 4, 3, 2, 1}},
+		{testName: "No repeated prefix", s: "abcde", expected: []int{5, 0, 0, 0, 0}},
+		{testName: "Periodic string", s: "abacaba", expected: []int{7, 0, 1, 0, 3, 0, 1}},
+		{testName: "Value inside the window reaches its end", s: "aabxaabxcaabxaabxay", expected: []int{19, 1, 0, 0, 4, 1, 0, 0, 0, 8, 1, 0, 0, 5, 1, 0, 0, 1, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expected, search.ZFunction(test.s))
+			assert.Equal(t, test.expected, search.ZFunction([]byte(test.s)))
+		})
+	}
+}
+
+func TestZFunction_AgainstDefinition(t *testing.T) {
+	for _, s := range []string{"abaababaabaab", "aaabaaaab", "xyxyxyzxyxy", "abcabcabcab"} {
+		z := search.ZFunction(s)
+
+		for i := range s {
+			k := 0
+			for i+k < len(s) && s[k] == s[i+k] {
+				k++
+			}
+
+			assert.Equal(t, k, z[i], "%q at %d", s, i)
+		}
+	}
+}
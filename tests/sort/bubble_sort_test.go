@@ -1 +1,119 @@
 package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSimpleSort struct {
+	testName string
+	sort     func(data []record, less func(a, b record) bool)
+	stable   bool
+}
+
+// simpleSorts lists the quadratic sorts that share the comparator signature.
+func simpleSorts() []testSimpleSort {
+	return []testSimpleSort{
+		{testName: "Bubble", sort: sort.BubbleSortFunc[record], stable: true},
+		{testName: "Cocktail shaker", sort: sort.CocktailShakerSortFunc[record], stable: true},
+		{testName: "Comb", sort: sort.CombSortFunc[record], stable: false},
+		{testName: "Insertion", sort: sort.InsertionSortFunc[record], stable: true},
+		{testName: "Binary insertion", sort: sort.BinaryInsertionSortFunc[record], stable: true},
+		{testName: "Selection", sort: sort.SelectionSortFunc[record], stable: false},
+	}
+}
+
+func TestSimpleSorts(t *testing.T) {
+	for _, algorithm := range simpleSorts() {
+		for _, test := range intSortCases() {
+			t.Run(algorithm.testName+"/"+test.testName, func(t *testing.T) {
+				data := make([]record, len(test.data))
+				for i, key := range test.data {
+					data[i] = record{key: key, seq: i}
+				}
+
+				algorithm.sort(data, recordLess)
+
+				assert.True(t, slices.IsSortedFunc(data, func(a, b record) int { return a.key - b.key }))
+				if algorithm.stable {
+					assertStablySorted(t, data)
+				}
+			})
+		}
+	}
+}
+
+func TestSimpleSorts_OrderedVariants(t *testing.T) {
+	sorts := map[string]func([]int){
+		"Bubble":           sort.BubbleSort[int],
+		"Cocktail shaker":  sort.CocktailShakerSort[int],
+		"Comb":             sort.CombSort[int],
+		"Insertion":        sort.InsertionSort[int],
+		"Binary insertion": sort.BinaryInsertionSort[int],
+		"Selection":        sort.SelectionSort[int],
+	}
+
+	for name, sortInts := range sorts {
+		t.Run(name, func(t *testing.T) {
+			data := randomInts(300, 50, 17)
+			expected := slices.Clone(data)
+			slices.Sort(expected)
+
+			sortInts(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestBubbleSort_EarlyExitOnSortedInput(t *testing.T) {
+	const n = 1000
+
+	comparisons := 0
+	sort.BubbleSortFunc(sort.Ascending(n), func(a, b int) bool {
+		comparisons++
+		return a < b
+	})
+
+	assert.Equal(t, n-1, comparisons)
+}
+
+func TestBubbleSort_LastSwapBound(t *testing.T) {
+	const n = 1000
+
+	// Only the first two elements are out of order: one full pass and one pass of length one.
+	data := sort.Ascending(n)
+	data[0], data[1] = data[1], data[0]
+
+	comparisons := 0
+	sort.BubbleSortFunc(data, func(a, b int) bool {
+		comparisons++
+		return a < b
+	})
+
+	assert.True(t, slices.IsSorted(data))
+	assert.Equal(t, n-1, comparisons)
+}
+
+func TestCocktailShakerSort_MovesTurtlesInOnePass(t *testing.T) {
+	const n = 1000
+
+	data := append(sort.Ascending(n)[1:], 0)
+
+	bubbleComparisons, shakerComparisons := 0, 0
+	sort.BubbleSortFunc(slices.Clone(data), func(a, b int) bool {
+		bubbleComparisons++
+		return a < b
+	})
+	sort.CocktailShakerSortFunc(data, func(a, b int) bool {
+		shakerComparisons++
+		return a < b
+	})
+
+	assert.True(t, slices.IsSorted(data))
+	assert.Less(t, shakerComparisons, 3*n)
+	assert.Greater(t, bubbleComparisons, n*n/4)
+}
@@ -0,0 +1,113 @@
+package sort_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testBucketSort struct {
+	testName string
+	data     []float64
+}
+
+func TestBucketSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(34))
+
+	uniform := make([]float64, 10_000)
+	skewed := make([]float64, 10_000)
+	for i := range uniform {
+		uniform[i] = rng.Float64()*200 - 100
+		skewed[i] = math.Pow(rng.Float64(), 12)
+	}
+
+	tests := []testBucketSort{
+		{testName: "Empty slice", data: []float64{}},
+		{testName: "Single value", data: []float64{1.5}},
+		{testName: "All equal", data: []float64{2, 2, 2, 2}},
+		{testName: "Small mixed", data: []float64{0.42, -3.5, 0.1, 7, -0.0, 0}},
+		{testName: "Uniform values", data: uniform},
+		{testName: "Skewed values", data: skewed},
+		{testName: "Extreme finite values", data: []float64{math.MaxFloat64, -math.MaxFloat64, 0, 1}},
+		{testName: "Infinities", data: []float64{1, math.Inf(1), -2, math.Inf(-1), 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.BucketSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestBucketSort_NaNFirst(t *testing.T) {
+	data := []float32{3, float32(math.NaN()), -1, float32(math.NaN()), 2}
+
+	sort.BucketSort(data)
+
+	assert.True(t, math.IsNaN(float64(data[0])))
+	assert.True(t, math.IsNaN(float64(data[1])))
+	assert.Equal(t, []float32{-1, 2, 3}, data[2:])
+}
+
+func TestBucketSortBy_IsStable(t *testing.T) {
+	type reading struct {
+		value float64
+		seq   int
+	}
+
+	data := make([]reading, 5000)
+	for i, key := range randomInts(len(data), 40, 35) {
+		data[i] = reading{value: float64(key) / 4, seq: i}
+	}
+	data[7].value = math.NaN()
+
+	sort.BucketSortBy(data, func(r reading) float64 { return r.value })
+
+	assert.True(t, math.IsNaN(data[0].value))
+	for i := 2; i < len(data); i++ {
+		assert.LessOrEqual(t, data[i-1].value, data[i].value)
+		if data[i-1].value == data[i].value {
+			assert.Less(t, data[i-1].seq, data[i].seq)
+		}
+	}
+}
+
+func BenchmarkBucketSort(b *testing.B) {
+	rng := rand.New(rand.NewSource(36))
+	input := make([]float64, 1_000_000)
+	for i := range input {
+		input[i] = rng.Float64()
+	}
+	data := make([]float64, len(input))
+
+	b.Run("BucketSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.BucketSort(data)
+		}
+	})
+
+	b.Run("QuickSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.QuickSort(data)
+		}
+	})
+
+	b.Run("slices.Sort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			slices.Sort(data)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package sort_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountingSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			assert.NoError(t, sort.CountingSort(data))
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestCountingSort_IntegerWidths(t *testing.T) {
+	int8s := []int8{127, -128, 0, -1, 1, -128, 127}
+	assert.NoError(t, sort.CountingSort(int8s))
+	assert.Equal(t, []int8{-128, -128, -1, 0, 1, 127, 127}, int8s)
+
+	uint16s := []uint16{65535, 0, 300, 2}
+	assert.NoError(t, sort.CountingSort(uint16s))
+	assert.Equal(t, []uint16{0, 2, 300, 65535}, uint16s)
+
+	int64s := []int64{math.MaxInt64, math.MaxInt64 - 3, math.MaxInt64 - 1}
+	assert.NoError(t, sort.CountingSort(int64s))
+	assert.Equal(t, []int64{math.MaxInt64 - 3, math.MaxInt64 - 1, math.MaxInt64}, int64s)
+}
+
+func TestCountingSort_RangeTooLarge(t *testing.T) {
+	data := []int64{math.MinInt64, 0, math.MaxInt64}
+
+	err := sort.CountingSort(data)
+
+	assert.ErrorIs(t, err, sort.ErrKeyRangeTooLarge)
+	assert.Equal(t, []int64{math.MinInt64, 0, math.MaxInt64}, data)
+
+	records := []record{{key: 0}, {key: sort.MaxCountingRange}}
+	assert.ErrorIs(t, sort.CountingSortBy(records, func(r record) int { return r.key }), sort.ErrKeyRangeTooLarge)
+}
+
+func TestCountingSortBy_IsStable(t *testing.T) {
+	data := randomRecords(10_000, 50, 26)
+	for i := range data {
+		data[i].key -= 25
+	}
+
+	assert.NoError(t, sort.CountingSortBy(data, func(r record) int { return r.key }))
+
+	assertStablySorted(t, data)
+}
@@ -0,0 +1,56 @@
+package sort_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+)
+
+type distribution struct {
+	name     string
+	generate func(n int) []int
+}
+
+// distributions returns the input shapes used to compare the sorts.
+func distributions() []distribution {
+	return []distribution{
+		{name: "random", generate: func(n int) []int { return randomInts(n, n, 37) }},
+		{name: "sorted", generate: sort.Ascending},
+		{name: "reversed", generate: sort.Descending},
+		{name: "sawtooth", generate: func(n int) []int { return sort.Sawtooth(n, 1000) }},
+		{name: "organ-pipe", generate: sort.OrganPipe},
+		{name: "few-unique", generate: func(n int) []int { return sort.FewUnique(n, 8, 38) }},
+	}
+}
+
+func BenchmarkSortDistributions(b *testing.B) {
+	const n = 1_000_000
+
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{name: "TimSort", sort: sort.TimSort[int]},
+		{name: "PDQSort", sort: sort.PDQSort[int]},
+		{name: "QuickSort", sort: sort.QuickSort[int]},
+		{name: "MergeSort", sort: sort.MergeSort[int]},
+		{name: "HeapSort", sort: sort.HeapSort[int]},
+		{name: "slices.Sort", sort: slices.Sort[[]int]},
+	}
+
+	for _, shape := range distributions() {
+		input := shape.generate(n)
+		data := make([]int, n)
+
+		for _, algorithm := range sorts {
+			b.Run(fmt.Sprintf("%s/%s", shape.name, algorithm.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(data, input)
+					algorithm.sort(data)
+				}
+			})
+		}
+	}
+}
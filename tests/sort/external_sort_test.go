@@ -0,0 +1,278 @@
+package sort_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testExternalSort struct {
+	testName     string
+	memoryBudget int64
+	workers      int
+	fanIn        int
+}
+
+// recordCodec encodes records as "key,seq" lines.
+type recordCodec struct{}
+
+func (recordCodec) Decode(r *bufio.Reader) (record, error) {
+	line, err := sort.LinesCodec{}.Decode(r)
+	if err != nil {
+		return record{}, err
+	}
+
+	key, seq, ok := strings.Cut(line, ",")
+	if !ok {
+		return record{}, fmt.Errorf("malformed record %q", line)
+	}
+
+	k, err := strconv.Atoi(key)
+	if err != nil {
+		return record{}, err
+	}
+
+	n, err := strconv.Atoi(seq)
+	if err != nil {
+		return record{}, err
+	}
+
+	return record{key: k, seq: n}, nil
+}
+
+func (recordCodec) Encode(w *bufio.Writer, r record) error {
+	_, err := fmt.Fprintf(w, "%d,%d\n", r.key, r.seq)
+
+	return err
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func encodeRecords(t *testing.T, records []record) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := bufio.NewWriter(&buf)
+	for _, r := range records {
+		assert.NoError(t, recordCodec{}.Encode(writer, r))
+	}
+	assert.NoError(t, writer.Flush())
+
+	return &buf
+}
+
+func decodeRecords(t *testing.T, data []byte) []record {
+	t.Helper()
+
+	var records []record
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for {
+		r, err := recordCodec{}.Decode(reader)
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+
+		assert.NoError(t, err)
+		records = append(records, r)
+	}
+}
+
+func TestExternalSorter_Records(t *testing.T) {
+	input := randomRecords(20_000, 300, 44)
+
+	tests := []testExternalSort{
+		{testName: "Fits in memory", memoryBudget: 1 << 30},
+		{testName: "Many runs", memoryBudget: 4096},
+		{testName: "Many runs with multi-pass merge", memoryBudget: 4096, fanIn: 3},
+		{testName: "Parallel run generation", memoryBudget: 16384, workers: 4},
+		{testName: "Parallel with multi-pass merge", memoryBudget: 16384, workers: 3, fanIn: 2},
+		{testName: "Tiny budget keeps one record per run", memoryBudget: 1, fanIn: 64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			data := input
+			if test.memoryBudget == 1 {
+				data = input[:500]
+			}
+
+			tempDir := t.TempDir()
+			sorter := sort.NewExternalSorter[record](recordCodec{}, recordLess)
+			sorter.MemoryBudget = test.memoryBudget
+			sorter.Workers = test.workers
+			sorter.FanIn = test.fanIn
+			sorter.TempDir = tempDir
+
+			var output bytes.Buffer
+			assert.NoError(t, sorter.Sort(encodeRecords(t, data), &output))
+
+			sorted := decodeRecords(t, output.Bytes())
+			assert.Len(t, sorted, len(data))
+			assertStablySorted(t, sorted)
+
+			entries, err := os.ReadDir(tempDir)
+			assert.NoError(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestExternalSorter_Lines(t *testing.T) {
+	words := randomWords(5000, 12, "abcdefghij", 45)
+	input := strings.Join(words, "\r\n")
+
+	sorter := sort.NewExternalSorter[string](sort.LinesCodec{}, func(a, b string) bool { return a < b })
+	sorter.MemoryBudget = 2048
+	sorter.TempDir = t.TempDir()
+
+	var output bytes.Buffer
+	assert.NoError(t, sorter.Sort(strings.NewReader(input), &output))
+
+	expected := slices.Clone(words)
+	slices.Sort(expected)
+	assert.Equal(t, strings.Join(expected, "\n")+"\n", output.String())
+}
+
+func TestExternalSorter_Int64(t *testing.T) {
+	values := make([]int64, 10_000)
+	for i, v := range randomInts(len(values), 1<<20, 46) {
+		values[i] = int64(v) - 1<<19
+	}
+
+	var input bytes.Buffer
+	writer := bufio.NewWriter(&input)
+	for _, v := range values {
+		assert.NoError(t, sort.Int64Codec{}.Encode(writer, v))
+	}
+	assert.NoError(t, writer.Flush())
+
+	sorter := sort.NewExternalSorter[int64](sort.Int64Codec{}, func(a, b int64) bool { return a < b })
+	sorter.MemoryBudget = 8 * 1000
+	sorter.Workers = 2
+	sorter.TempDir = t.TempDir()
+
+	var output bytes.Buffer
+	assert.NoError(t, sorter.Sort(&input, &output))
+
+	reader := bufio.NewReader(&output)
+	var sorted []int64
+	for {
+		v, err := sort.Int64Codec{}.Decode(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.NoError(t, err)
+		sorted = append(sorted, v)
+	}
+
+	slices.Sort(values)
+	assert.Equal(t, values, sorted)
+}
+
+func TestExternalSorter_EmptyInput(t *testing.T) {
+	sorter := sort.NewExternalSorter[string](sort.LinesCodec{}, func(a, b string) bool { return a < b })
+
+	var output bytes.Buffer
+	assert.NoError(t, sorter.Sort(strings.NewReader(""), &output))
+	assert.Empty(t, output.String())
+}
+
+func TestExternalSorter_Errors(t *testing.T) {
+	less := func(a, b int64) bool { return a < b }
+
+	var incomplete sort.ExternalSorter[int64]
+	assert.ErrorIs(t, incomplete.Sort(strings.NewReader(""), io.Discard), sort.ErrIncompleteSorter)
+
+	t.Run("Truncated record", func(t *testing.T) {
+		sorter := sort.NewExternalSorter[int64](sort.Int64Codec{}, less)
+		sorter.MemoryBudget = 8
+		sorter.TempDir = t.TempDir()
+
+		input := append(bytes.Repeat([]byte{0, 0, 0, 0, 0, 0, 0, 1}, 3), 0, 0, 0)
+		err := sorter.Sort(bytes.NewReader(input), io.Discard)
+
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		entries, _ := os.ReadDir(sorter.TempDir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Malformed record in a later chunk", func(t *testing.T) {
+		sorter := sort.NewExternalSorter[record](recordCodec{}, recordLess)
+		sorter.MemoryBudget = 64
+		sorter.Workers = 2
+		sorter.TempDir = t.TempDir()
+
+		input := encodeRecords(t, randomRecords(100, 10, 47))
+		input.WriteString("broken\n")
+
+		err := sorter.Sort(input, io.Discard)
+
+		assert.ErrorContains(t, err, `malformed record "broken"`)
+	})
+
+	t.Run("Failing output", func(t *testing.T) {
+		sorter := sort.NewExternalSorter[record](recordCodec{}, recordLess)
+		sorter.MemoryBudget = 256
+		sorter.TempDir = t.TempDir()
+
+		err := sorter.Sort(encodeRecords(t, randomRecords(1000, 10, 48)), failingWriter{})
+
+		assert.ErrorContains(t, err, "disk full")
+	})
+
+	t.Run("Missing temp dir", func(t *testing.T) {
+		sorter := sort.NewExternalSorter[record](recordCodec{}, recordLess)
+		sorter.MemoryBudget = 256
+		sorter.TempDir = t.TempDir() + "/missing"
+
+		err := sorter.Sort(encodeRecords(t, randomRecords(1000, 10, 49)), io.Discard)
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func BenchmarkExternalSorter(b *testing.B) {
+	values := randomInts(1<<18, 1<<30, 50)
+
+	var input bytes.Buffer
+	writer := bufio.NewWriter(&input)
+	for _, v := range values {
+		_ = sort.Int64Codec{}.Encode(writer, int64(v))
+	}
+	_ = writer.Flush()
+
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			sorter := sort.NewExternalSorter[int64](sort.Int64Codec{}, func(a, b int64) bool { return a < b })
+			sorter.MemoryBudget = int64(input.Len() / 8)
+			sorter.Workers = workers
+			sorter.TempDir = b.TempDir()
+
+			b.SetBytes(int64(input.Len()))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if err := sorter.Sort(bytes.NewReader(input.Bytes()), io.Discard); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
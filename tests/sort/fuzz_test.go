@@ -0,0 +1,305 @@
+package sort_test
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+)
+
+type fuzzSort struct {
+	name   string
+	sort   func(t *testing.T, data []record)
+	stable bool
+}
+
+// recordCompare orders records by key only, as recordLess does.
+func recordCompare(a, b record) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// fuzzRecords decodes fuzz input into records whose keys repeat often, numbered in input order.
+func fuzzRecords(input []byte) []record {
+	data := make([]record, len(input))
+	for i, b := range input {
+		data[i] = record{key: int(b%32) - 8, seq: i}
+	}
+
+	return data
+}
+
+// recordSorts returns every comparison and key-based sort of the package, applied to records.
+func recordSorts() []fuzzSort {
+	less := recordLess
+	merge := func(mode sort.MergeMode, threshold int) func(t *testing.T, data []record) {
+		return func(t *testing.T, data []record) {
+			sorter := sort.NewMergeSorter(less, mode)
+			sorter.ParallelThreshold = threshold
+			sorter.Workers = 3
+			sorter.Sort(data)
+		}
+	}
+	plain := func(sortFunc func(data []record, less func(a, b record) bool)) func(t *testing.T, data []record) {
+		return func(t *testing.T, data []record) { sortFunc(data, less) }
+	}
+	network := func(build func(n int) (*sort.SortingNetwork, error)) func(t *testing.T, data []record) {
+		return func(t *testing.T, data []record) {
+			n, err := build(len(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := sort.SortWithNetworkFunc(n, data, less); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	sorts := []fuzzSort{
+		{name: "QuickSortFunc", sort: plain(sort.QuickSortFunc[record])},
+		{name: "QuickSortPivot/median-of-three", sort: func(t *testing.T, data []record) {
+			sort.QuickSortPivot(data, less, sort.PivotMedianOfThree)
+		}},
+		{name: "QuickSortPivot/ninther", sort: func(t *testing.T, data []record) {
+			sort.QuickSortPivot(data, less, sort.PivotNinther)
+		}},
+		{name: "MergeSortFunc", sort: plain(sort.MergeSortFunc[record]), stable: true},
+		{name: "MergeSorter/bottom-up", sort: merge(sort.MergeBottomUp, 0), stable: true},
+		{name: "MergeSorter/parallel", sort: merge(sort.MergeParallel, 4), stable: true},
+		{name: "HeapSortFunc", sort: plain(sort.HeapSortFunc[record])},
+		{name: "PartialSortFunc", sort: func(t *testing.T, data []record) { sort.PartialSortFunc(data, len(data), less) }},
+		{name: "BubbleSortFunc", sort: plain(sort.BubbleSortFunc[record]), stable: true},
+		{name: "CocktailShakerSortFunc", sort: plain(sort.CocktailShakerSortFunc[record]), stable: true},
+		{name: "CombSortFunc", sort: plain(sort.CombSortFunc[record])},
+		{name: "InsertionSortFunc", sort: plain(sort.InsertionSortFunc[record]), stable: true},
+		{name: "BinaryInsertionSortFunc", sort: plain(sort.BinaryInsertionSortFunc[record]), stable: true},
+		{name: "SelectionSortFunc", sort: plain(sort.SelectionSortFunc[record])},
+		{name: "TimSortFunc", sort: plain(sort.TimSortFunc[record]), stable: true},
+		{name: "PDQSortFunc", sort: plain(sort.PDQSortFunc[record])},
+		{name: "SampleSortParallel", sort: func(t *testing.T, data []record) {
+			sort.SampleSortParallel(data, less, 3)
+		}, stable: true},
+		{name: "BitonicNetwork", sort: network(sort.BitonicNetwork)},
+		{name: "OddEvenMergeNetwork", sort: network(sort.OddEvenMergeNetwork)},
+		{name: "CountingSortBy", sort: func(t *testing.T, data []record) {
+			if err := sort.CountingSortBy(data, func(r record) int { return r.key }); err != nil {
+				t.Fatal(err)
+			}
+		}, stable: true},
+		{name: "RadixSortBy", sort: func(t *testing.T, data []record) {
+			sort.RadixSortBy(data, func(r record) int { return r.key })
+		}, stable: true},
+		{name: "BucketSortBy", sort: func(t *testing.T, data []record) {
+			sort.BucketSortBy(data, func(r record) float64 { return float64(r.key) })
+		}, stable: true},
+		{name: "MSDRadixSortBy", sort: func(t *testing.T, data []record) {
+			// Shifting the keys to non-negative single bytes makes their byte order the numeric order.
+			sort.MSDRadixSortBy(data, func(r record) string { return string([]byte{byte(r.key + 8)}) })
+		}, stable: true},
+		{name: "ExternalSorter", sort: func(t *testing.T, data []record) {
+			sorter := sort.NewExternalSorter[record](recordCodec{}, less)
+			sorter.MemoryBudget = 512
+			sorter.FanIn = 3
+			sorter.TempDir = t.TempDir()
+
+			var output bytes.Buffer
+			if err := sorter.Sort(encodeRecords(t, data), &output); err != nil {
+				t.Fatal(err)
+			}
+
+			copy(data, decodeRecords(t, output.Bytes()))
+		}, stable: true},
+	}
+
+	for _, algorithm := range sort.Algorithms() {
+		sorts = append(sorts, fuzzSort{
+			name: "SortTraced/" + algorithm.String(),
+			sort: func(t *testing.T, data []record) {
+				if err := sort.SortTraced(algorithm, data, less, &sort.Counter[record]{}); err != nil {
+					t.Fatal(err)
+				}
+			},
+		})
+	}
+
+	return sorts
+}
+
+// FuzzSorts checks every sort of the package against slices.SortStableFunc. Inputs are capped at
+// 256 records, since the quadratic sorts dominate the run time. Stable sorts must
+// reproduce the oracle exactly; the others must produce the same keys and a permutation of the input.
+func FuzzSorts(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 1, 2})
+	f.Add([]byte("the quick brown fox jumps over the lazy dog"))
+	f.Add(bytes.Repeat([]byte{7}, 100))
+
+	killer := sort.MedianOfThreeKiller(200)
+	seed := make([]byte, len(killer))
+	for i, v := range killer {
+		seed[i] = byte(v)
+	}
+	f.Add(seed)
+
+	sorts := recordSorts()
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		if len(input) > 256 {
+			input = input[:256]
+		}
+
+		original := fuzzRecords(input)
+		expected := slices.Clone(original)
+		slices.SortStableFunc(expected, recordCompare)
+
+		for _, algorithm := range sorts {
+			data := slices.Clone(original)
+			algorithm.sort(t, data)
+
+			if algorithm.stable {
+				if !sort.IsStableSorted(original, data, recordLess) {
+					t.Fatalf("%s: got %v, want %v", algorithm.name, data, expected)
+				}
+
+				continue
+			}
+
+			if !slices.EqualFunc(expected, data, func(a, b record) bool { return a.key == b.key }) {
+				t.Fatalf("%s: keys %v, want %v", algorithm.name, data, expected)
+			}
+
+			slices.SortFunc(data, func(a, b record) int { return a.seq - b.seq })
+			if !slices.Equal(original, data) {
+				t.Fatalf("%s: output is not a permutation of the input", algorithm.name)
+			}
+		}
+	})
+}
+
+// FuzzIntegerSorts checks the ordered and integer sorts on full-range int64 values.
+func FuzzIntegerSorts(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 1, 255, 255, 255, 255, 255, 255, 255, 255})
+	f.Add(bytes.Repeat([]byte{0x80, 0, 0, 0, 0, 0, 0, 0, 0x7f, 255, 255, 255, 255, 255, 255, 255}, 8))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		original := make([]int64, len(input)/8)
+		for i := range original {
+			original[i] = int64(binary.BigEndian.Uint64(input[8*i:]))
+		}
+
+		expected := slices.Clone(original)
+		slices.Sort(expected)
+
+		sorts := map[string]func(data []int64){
+			"QuickSort":  sort.QuickSort[int64],
+			"MergeSort":  sort.MergeSort[int64],
+			"HeapSort":   sort.HeapSort[int64],
+			"TimSort":    sort.TimSort[int64],
+			"PDQSort":    sort.PDQSort[int64],
+			"SampleSort": sort.SampleSort[int64],
+			"RadixSort":  sort.RadixSort[int64],
+			"CountingSort": func(data []int64) {
+				// Wide key ranges are rejected by design; the data is then left untouched.
+				if err := sort.CountingSort(data); err != nil {
+					slices.Sort(data)
+				}
+			},
+		}
+
+		for name, sortFunc := range sorts {
+			data := slices.Clone(original)
+			sortFunc(data)
+
+			if !slices.Equal(expected, data) {
+				t.Fatalf("%s: got %v, want %v", name, data, expected)
+			}
+		}
+	})
+}
+
+// FuzzFloatSorts checks the sorts on float64 values including NaN, infinities and signed zeros,
+// which must end up in the order of cmp.Compare with equal values in input order.
+func FuzzFloatSorts(f *testing.F) {
+	f.Add([]byte{})
+	f.Add(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, math.Float64bits(math.NaN())), 0x8000000000000000))
+	f.Add(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, math.Float64bits(math.Inf(1))), 1))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		original := make([]float64, len(input)/8)
+		for i := range original {
+			original[i] = math.Float64frombits(binary.BigEndian.Uint64(input[8*i:]))
+		}
+
+		expected := slices.Clone(original)
+		slices.SortStableFunc(expected, cmp.Compare[float64])
+
+		sorts := map[string]func(data []float64){
+			"BucketSort": sort.BucketSort[float64],
+			"MergeSort":  sort.MergeSort[float64],
+			"TimSort":    sort.TimSort[float64],
+		}
+
+		for name, sortFunc := range sorts {
+			data := slices.Clone(original)
+			sortFunc(data)
+
+			if !slices.EqualFunc(expected, data, func(a, b float64) bool {
+				return math.Float64bits(a) == math.Float64bits(b)
+			}) {
+				t.Fatalf("%s: got %v, want %v", name, data, expected)
+			}
+		}
+
+		for name, sortFunc := range map[string]func(data []float64){
+			"QuickSort": sort.QuickSort[float64],
+			"PDQSort":   sort.PDQSort[float64],
+			"HeapSort":  sort.HeapSort[float64],
+		} {
+			data := slices.Clone(original)
+			sortFunc(data)
+
+			if !sort.IsSorted(data) {
+				t.Fatalf("%s: got %v", name, data)
+			}
+		}
+	})
+}
+
+// FuzzStringSorts checks the MSD radix sort of strings against slices.Sort.
+func FuzzStringSorts(f *testing.F) {
+	f.Add("")
+	f.Add("b a ab aa a\x00 \xff")
+	f.Add("she sells sea shells by the sea shore")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		original := strings.Split(input, " ")
+		expected := slices.Clone(original)
+		slices.Sort(expected)
+
+		data := slices.Clone(original)
+		sort.MSDRadixSort(data)
+
+		if !slices.Equal(expected, data) {
+			t.Fatalf("MSDRadixSort: got %q, want %q", data, expected)
+		}
+
+		bytesData := make([][]byte, len(original))
+		for i, s := range original {
+			bytesData[i] = []byte(s)
+		}
+
+		sort.MSDRadixSort(bytesData)
+
+		for i := range bytesData {
+			if string(bytesData[i]) != expected[i] {
+				t.Fatalf("MSDRadixSort of byte slices: got %q at %d, want %q", bytesData[i], i, expected[i])
+			}
+		}
+	})
+}
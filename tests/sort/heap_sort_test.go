@@ -1 +1,99 @@
 package sort_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPartialSort struct {
+	testName string
+	data     []int
+	k        int
+}
+
+func TestHeapSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.HeapSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestHeapSortFunc_Descending(t *testing.T) {
+	words := []string{"pear", "apple", "fig", "banana", "apple", "cherry"}
+
+	sort.HeapSortFunc(words, func(a, b string) bool { return a > b })
+
+	assert.Equal(t, []string{"pear", "fig", "cherry", "banana", "apple", "apple"}, words)
+}
+
+func TestPartialSort(t *testing.T) {
+	tests := []testPartialSort{
+		{testName: "Empty slice", data: []int{}, k: 3},
+		{testName: "Zero k", data: []int{3, 1, 2}, k: 0},
+		{testName: "Negative k", data: []int{3, 1, 2}, k: -1},
+		{testName: "Single smallest", data: []int{4, 2, 8, 1, 9}, k: 1},
+		{testName: "Three smallest with duplicates", data: []int{5, 1, 4, 1, 5, 9, 2, 6}, k: 3},
+		{testName: "k equals length", data: []int{3, 2, 1}, k: 3},
+		{testName: "k greater than length", data: []int{3, 2, 1}, k: 10},
+		{testName: "Large random", data: randomInts(10_000, 1000, 14), k: 100},
+		{testName: "Large reversed", data: sort.Descending(5000), k: 2500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+			k := max(0, min(test.k, len(data)))
+
+			sort.PartialSort(data, test.k)
+
+			assert.Equal(t, expected[:k], data[:k])
+
+			rest := slices.Clone(data[k:])
+			slices.Sort(rest)
+			assert.Equal(t, expected[k:], rest)
+		})
+	}
+}
+
+func TestPartialSortFunc_LargestFirst(t *testing.T) {
+	data := randomRecords(1000, 500, 15)
+
+	sort.PartialSortFunc(data, 5, func(a, b record) bool { return a.key > b.key })
+
+	for i := 1; i < 5; i++ {
+		assert.GreaterOrEqual(t, data[i-1].key, data[i].key)
+	}
+
+	for _, rest := range data[5:] {
+		assert.GreaterOrEqual(t, data[4].key, rest.key)
+	}
+}
+
+func BenchmarkPartialSort(b *testing.B) {
+	const n = 1_000_000
+
+	input := randomInts(n, n, 16)
+	data := make([]int, n)
+
+	for _, k := range []int{10, 1000, n} {
+		b.Run(fmt.Sprintf("k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				sort.PartialSort(data, k)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinaryInsertionSort_UsesLogarithmicComparisons(t *testing.T) {
+	const n = 1024
+
+	data := randomInts(n, n, 18)
+	linear, binary := 0, 0
+
+	sort.InsertionSortFunc(slices.Clone(data), func(a, b int) bool {
+		linear++
+		return a < b
+	})
+	sort.BinaryInsertionSortFunc(data, func(a, b int) bool {
+		binary++
+		return a < b
+	})
+
+	assert.True(t, slices.IsSorted(data))
+	assert.LessOrEqual(t, binary, n*11)
+	assert.Greater(t, linear, n*n/8)
+}
+
+func TestInsertionSort_Strings(t *testing.T) {
+	words := []string{"pear", "apple", "fig", "banana", "apple", "cherry"}
+	expected := []string{"apple", "apple", "banana", "cherry", "fig", "pear"}
+
+	linear := slices.Clone(words)
+	sort.InsertionSort(linear)
+	assert.Equal(t, expected, linear)
+
+	sort.BinaryInsertionSort(words)
+	assert.Equal(t, expected, words)
+}
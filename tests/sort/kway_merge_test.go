@@ -0,0 +1,247 @@
+package sort_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/data_structures/linked_lists"
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testKWayMerge struct {
+	testName string
+	sources  [][]int
+}
+
+var kWayStrategies = map[string]sort.KWayStrategy{
+	"heap":       sort.KWayHeap,
+	"loser tree": sort.KWayLoserTree,
+}
+
+// sortedShards returns k sorted shards of pseudo-random lengths up to maxLen.
+func sortedShards(k, maxLen, limit int, seed int64) [][]int {
+	lengths := randomInts(k, maxLen+1, seed)
+	shards := make([][]int, k)
+
+	for i, n := range lengths {
+		shards[i] = randomInts(n, limit, seed+int64(i)+1)
+		slices.Sort(shards[i])
+	}
+
+	return shards
+}
+
+func TestKWayMerge(t *testing.T) {
+	tests := []testKWayMerge{
+		{testName: "No sources", sources: nil},
+		{testName: "Only empty sources", sources: [][]int{{}, nil, {}}},
+		{testName: "Single source", sources: [][]int{{1, 2, 3}}},
+		{testName: "Two interleaved sources", sources: [][]int{{1, 3, 5}, {2, 4, 6}}},
+		{testName: "Sources of different lengths", sources: [][]int{{7}, {}, {1, 1, 2, 9, 10}, {3, 8}}},
+		{testName: "Non power of two source count", sources: sortedShards(7, 50, 30, 51)},
+		{testName: "Many sources", sources: sortedShards(300, 40, 1000, 52)},
+	}
+
+	for name, strategy := range kWayStrategies {
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%s/%s", name, test.testName), func(t *testing.T) {
+				expected := []int{}
+				for _, source := range test.sources {
+					expected = append(expected, source...)
+				}
+				slices.Sort(expected)
+
+				merger := sort.NewKWayMerger(func(a, b int) bool { return a < b })
+				merger.Strategy = strategy
+
+				assert.Equal(t, expected, merger.Merge(test.sources...))
+				if strategy == sort.KWayHeap {
+					assert.Equal(t, expected, sort.KWayMerge(test.sources...))
+				}
+			})
+		}
+	}
+}
+
+func TestKWayMerge_Stable(t *testing.T) {
+	sources := make([][]record, 9)
+	for i := range sources {
+		sources[i] = randomRecords(200, 20, int64(60+i))
+		for j := range sources[i] {
+			sources[i][j].seq += i * 1000
+		}
+		slices.SortStableFunc(sources[i], func(a, b record) int { return a.key - b.key })
+	}
+
+	for name, strategy := range kWayStrategies {
+		t.Run(name, func(t *testing.T) {
+			merger := sort.NewKWayMerger(recordLess)
+			merger.Strategy = strategy
+
+			merged := merger.Merge(sources...)
+
+			assert.Len(t, merged, 9*200)
+			assertStablySorted(t, merged)
+		})
+	}
+
+	assertStablySorted(t, sort.KWayMergeFunc(sources, recordLess))
+}
+
+func TestKWayMerge_Dedup(t *testing.T) {
+	sources := [][]record{
+		{{key: 1, seq: 0}, {key: 1, seq: 1}, {key: 4, seq: 2}},
+		{{key: 1, seq: 10}, {key: 2, seq: 11}, {key: 4, seq: 12}},
+		{{key: 2, seq: 20}, {key: 5, seq: 21}},
+	}
+
+	for name, strategy := range kWayStrategies {
+		t.Run(name, func(t *testing.T) {
+			merger := sort.NewKWayMerger(recordLess)
+			merger.Strategy = strategy
+			merger.Dedup = true
+
+			assert.Equal(t, []record{{1, 0}, {2, 11}, {4, 2}, {5, 21}}, merger.Merge(sources...))
+		})
+	}
+}
+
+func TestKWayMerger_AllStopsEarly(t *testing.T) {
+	for name, strategy := range kWayStrategies {
+		t.Run(name, func(t *testing.T) {
+			merger := sort.NewKWayMerger(func(a, b int) bool { return a < b })
+			merger.Strategy = strategy
+
+			var first []int
+			merger.All([]int{1, 4, 7}, []int{2, 5, 8}, []int{3, 6, 9})(func(value int) bool {
+				first = append(first, value)
+				return len(first) < 4
+			})
+
+			assert.Equal(t, []int{1, 2, 3, 4}, first)
+		})
+	}
+}
+
+func TestKWayMerger_MergeIterators(t *testing.T) {
+	lists := []linked_lists.List[string]{
+		linked_lists.NewList("apple", "fig", "plum"),
+		linked_lists.NewList[string](),
+		linked_lists.NewList("banana", "cherry", "fig"),
+		linked_lists.NewList("kiwi"),
+	}
+
+	for name, strategy := range kWayStrategies {
+		t.Run(name, func(t *testing.T) {
+			merger := sort.NewKWayMerger(func(a, b string) bool { return a < b })
+			merger.Strategy = strategy
+			merger.Dedup = true
+
+			sources := make([]func(yield func(string) bool), len(lists))
+			for i, list := range lists {
+				sources[i] = list.All()
+			}
+
+			var merged []string
+			merger.MergeIterators(sources...)(func(value string) bool {
+				merged = append(merged, value)
+				return value != "fig"
+			})
+
+			assert.Equal(t, []string{"apple", "banana", "cherry", "fig"}, merged)
+		})
+	}
+}
+
+func TestKWayMerger_MergeChannels(t *testing.T) {
+	shards := sortedShards(5, 100, 50, 53)
+	expected := []int{}
+	for _, shard := range shards {
+		expected = append(expected, shard...)
+	}
+	slices.Sort(expected)
+
+	for name, strategy := range kWayStrategies {
+		t.Run(name, func(t *testing.T) {
+			sources := make([]<-chan int, len(shards))
+			for i, shard := range shards {
+				ch := make(chan int)
+				go func() {
+					defer close(ch)
+					for _, v := range shard {
+						ch <- v
+					}
+				}()
+				sources[i] = ch
+			}
+
+			merger := sort.NewKWayMerger(func(a, b int) bool { return a < b })
+			merger.Strategy = strategy
+
+			var merged []int
+			for v := range merger.MergeChannels(context.Background(), sources...) {
+				merged = append(merged, v)
+			}
+
+			assert.Equal(t, expected, merged)
+		})
+	}
+}
+
+func TestKWayMerger_MergeChannelsCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The sources never close, so only the cancellation can end the merge.
+	endless := func(start int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			for v := start; ; v += 2 {
+				select {
+				case ch <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		return ch
+	}
+
+	merged := sort.NewKWayMerger(func(a, b int) bool { return a < b }).MergeChannels(ctx, endless(0), endless(1))
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, i, <-merged)
+	}
+
+	cancel()
+
+	for range merged {
+	}
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func BenchmarkKWayMerge(b *testing.B) {
+	for _, k := range []int{4, 64, 1024} {
+		shards := sortedShards(k, 2*(1<<18)/k, 1<<30, 54)
+
+		for name, strategy := range kWayStrategies {
+			b.Run(fmt.Sprintf("k=%d/%s", k, name), func(b *testing.B) {
+				merger := sort.NewKWayMerger(func(a, b int) bool { return a < b })
+				merger.Strategy = strategy
+
+				for i := 0; i < b.N; i++ {
+					count := 0
+					merger.All(shards...)(func(int) bool {
+						count++
+						return true
+					})
+				}
+			})
+		}
+	}
+}
@@ -1 +1,202 @@
 package sort_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMergeSorter struct {
+	testName string
+	sorter   *sort.MergeSorter[record]
+}
+
+// randomRecords returns n records with keys in [0, keys) whose seq field holds their original position.
+func randomRecords(n, keys int, seed int64) []record {
+	data := make([]record, n)
+	for i, key := range randomInts(n, keys, seed) {
+		data[i] = record{key: key, seq: i}
+	}
+
+	return data
+}
+
+func recordLess(a, b record) bool {
+	return a.key < b.key
+}
+
+// assertStablySorted checks that records are ordered by key and that equal keys keep their original order.
+func assertStablySorted(t *testing.T, data []record) {
+	t.Helper()
+
+	for i := 1; i < len(data); i++ {
+		if data[i-1].key > data[i].key || (data[i-1].key == data[i].key && data[i-1].seq > data[i].seq) {
+			assert.Failf(t, "not stably sorted", "position %d: %+v before %+v", i, data[i-1], data[i])
+			return
+		}
+	}
+}
+
+func mergeSorters() []testMergeSorter {
+	parallel := func(workers, threshold int) *sort.MergeSorter[record] {
+		sorter := sort.NewMergeSorter(recordLess, sort.MergeParallel)
+		sorter.Workers = workers
+		sorter.ParallelThreshold = threshold
+
+		return sorter
+	}
+
+	return []testMergeSorter{
+		{testName: "Top-down", sorter: sort.NewMergeSorter(recordLess, sort.MergeTopDown)},
+		{testName: "Bottom-up natural runs", sorter: sort.NewMergeSorter(recordLess, sort.MergeBottomUp)},
+		{testName: "Parallel single worker", sorter: parallel(1, 64)},
+		{testName: "Parallel four workers", sorter: parallel(4, 64)},
+		{testName: "Parallel default workers", sorter: parallel(0, 0)},
+	}
+}
+
+func TestMergeSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.MergeSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestMergeSorter_Modes(t *testing.T) {
+	for _, sorterCase := range mergeSorters() {
+		for _, test := range intSortCases() {
+			t.Run(fmt.Sprintf("%s/%s", sorterCase.testName, test.testName), func(t *testing.T) {
+				data := make([]record, len(test.data))
+				for i, key := range test.data {
+					data[i] = record{key: key, seq: i}
+				}
+
+				sorterCase.sorter.Sort(data)
+
+				assert.Len(t, data, len(test.data))
+				assertStablySorted(t, data)
+			})
+		}
+	}
+}
+
+func TestMergeSorter_StabilityWithManyEqualKeys(t *testing.T) {
+	for _, sorterCase := range mergeSorters() {
+		t.Run(sorterCase.testName, func(t *testing.T) {
+			data := randomRecords(50_000, 16, 8)
+
+			sorterCase.sorter.Sort(data)
+
+			assertStablySorted(t, data)
+		})
+	}
+}
+
+func TestMergeSorter_NaturalRuns(t *testing.T) {
+	runs := [][]int{
+		sort.Ascending(300),
+		sort.Descending(300),
+		append(sort.Ascending(200), sort.Descending(200)...),
+		append(sort.Descending(100), append(sort.Ascending(100), sort.Descending(100)...)...),
+	}
+
+	sorter := sort.NewMergeSorter(func(a, b int) bool { return a < b }, sort.MergeBottomUp)
+
+	for i, run := range runs {
+		t.Run(fmt.Sprintf("shape=%d", i), func(t *testing.T) {
+			expected := slices.Clone(run)
+			slices.Sort(expected)
+
+			sorter.Sort(run)
+
+			assert.Equal(t, expected, run)
+		})
+	}
+}
+
+func TestMergeSorter_DescendingRunsWithEqualKeysStayStable(t *testing.T) {
+	data := []record{{3, 0}, {3, 1}, {2, 2}, {2, 3}, {1, 4}, {1, 5}}
+	for i := 0; i < 20; i++ {
+		data = append(data, record{key: 0, seq: len(data)})
+	}
+
+	sort.NewMergeSorter(recordLess, sort.MergeBottomUp).Sort(data)
+
+	assertStablySorted(t, data)
+}
+
+func TestMergeSorter_ReusesBuffer(t *testing.T) {
+	sorter := sort.NewMergeSorter(func(a, b int) bool { return a < b }, sort.MergeTopDown)
+	sorter.Sort(randomInts(1000, 1000, 9))
+
+	data := randomInts(1000, 1000, 10)
+	allocs := testing.AllocsPerRun(10, func() {
+		sorter.Sort(data)
+	})
+
+	// Only the small sorter header is allocated, the scratch buffer is reused.
+	assert.LessOrEqual(t, allocs, 1.0)
+	assert.True(t, slices.IsSorted(data))
+}
+
+func TestMergeSortFunc_Descending(t *testing.T) {
+	data := randomRecords(1000, 10, 11)
+
+	sort.MergeSortFunc(data, func(a, b record) bool { return a.key > b.key })
+
+	for i := 1; i < len(data); i++ {
+		assert.GreaterOrEqual(t, data[i-1].key, data[i].key)
+		if data[i-1].key == data[i].key {
+			assert.Less(t, data[i-1].seq, data[i].seq)
+		}
+	}
+}
+
+func TestMergeSortParallel(t *testing.T) {
+	data := randomRecords(100_000, 100, 12)
+
+	sort.MergeSortParallel(data, recordLess, 3)
+
+	assertStablySorted(t, data)
+}
+
+func BenchmarkMergeSort(b *testing.B) {
+	const n = 1_000_000
+
+	input := randomInts(n, n, 13)
+	data := make([]int, n)
+	less := func(a, b int) bool { return a < b }
+
+	modes := []struct {
+		name    string
+		mode    sort.MergeMode
+		workers int
+	}{
+		{name: "top-down", mode: sort.MergeTopDown},
+		{name: "bottom-up", mode: sort.MergeBottomUp},
+		{name: "parallel-2", mode: sort.MergeParallel, workers: 2},
+		{name: "parallel-max", mode: sort.MergeParallel},
+	}
+
+	for _, mode := range modes {
+		sorter := sort.NewMergeSorter(less, mode.mode)
+		sorter.Workers = mode.workers
+
+		b.Run(mode.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				sorter.Sort(data)
+			}
+		})
+	}
+}
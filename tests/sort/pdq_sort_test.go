@@ -0,0 +1,78 @@
+package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPDQSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.PDQSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestPDQSort_Distributions(t *testing.T) {
+	for _, shape := range distributions() {
+		for _, n := range []int{23, 24, 129, 1000, 100_000} {
+			data := shape.generate(n)
+			expected := slices.Clone(data)
+			slices.Sort(expected)
+
+			sort.PDQSort(data)
+
+			assert.Equal(t, expected, data, "%s/n=%d", shape.name, n)
+		}
+	}
+}
+
+func TestPDQSortFunc_Records(t *testing.T) {
+	data := randomRecords(50_000, 100, 41)
+
+	sort.PDQSortFunc(data, func(a, b record) bool { return a.key > b.key })
+
+	assert.True(t, slices.IsSortedFunc(data, func(a, b record) int { return b.key - a.key }))
+}
+
+func TestPDQSort_PatternsAreCheap(t *testing.T) {
+	const n = 1 << 16
+
+	inputs := map[string][]int{
+		"sorted":     sort.Ascending(n),
+		"reversed":   sort.Descending(n),
+		"few-unique": randomInts(n, 4, 42),
+		"all-equal":  randomInts(n, 1, 43),
+	}
+
+	for name, data := range inputs {
+		t.Run(name, func(t *testing.T) {
+			counter := &sort.Counter[int]{}
+			assert.NoError(t, sort.SortTraced(sort.AlgorithmPDQ, data, func(a, b int) bool { return a < b }, counter))
+
+			assert.True(t, slices.IsSorted(data))
+			assert.Less(t, counter.Comparisons, 8*n)
+		})
+	}
+}
+
+func TestPDQSort_AdversarialInputStaysLinearithmic(t *testing.T) {
+	const n = 1 << 14
+
+	data := sort.MedianOfThreeKiller(n)
+	counter := &sort.Counter[int]{}
+
+	assert.NoError(t, sort.SortTraced(sort.AlgorithmPDQ, data, func(a, b int) bool { return a < b }, counter))
+
+	assert.True(t, slices.IsSorted(data))
+	assert.Less(t, counter.Comparisons, 4*n*14)
+}
@@ -1 +1,162 @@
 package sort_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSortInts struct {
+	testName string
+	data     []int
+}
+
+type record struct {
+	key int
+	seq int
+}
+
+// randomInts returns n pseudo-random values in [0, limit) generated from a fixed seed.
+func randomInts(n, limit int, seed int64) []int {
+	rng := rand.New(rand.NewSource(seed))
+	data := make([]int, n)
+	for i := range data {
+		data[i] = rng.Intn(limit)
+	}
+
+	return data
+}
+
+// intSortCases returns inputs that exercise the common edge cases of comparison sorts.
+func intSortCases() []testSortInts {
+	return []testSortInts{
+		{testName: "Nil slice", data: nil},
+		{testName: "Empty slice", data: []int{}},
+		{testName: "Single element", data: []int{42}},
+		{testName: "Two elements", data: []int{2, 1}},
+		{testName: "Small unsorted", data: []int{5, 2, 9, 1, 5, 6, -3, 0}},
+		{testName: "Already sorted", data: sort.Ascending(1000)},
+		{testName: "Reversed", data: sort.Descending(1000)},
+		{testName: "All equal", data: randomInts(500, 1, 1)},
+		{testName: "Few unique values", data: randomInts(2000, 4, 2)},
+		{testName: "Random values", data: randomInts(5000, 1_000_000, 3)},
+		{testName: "Random with negatives", data: func() []int {
+			data := randomInts(3000, 2000, 4)
+			for i := range data {
+				data[i] -= 1000
+			}
+
+			return data
+		}()},
+	}
+}
+
+func TestQuickSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.QuickSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestQuickSortPivot(t *testing.T) {
+	strategies := map[string]sort.PivotStrategy{
+		"adaptive":        sort.PivotAdaptive,
+		"median-of-three": sort.PivotMedianOfThree,
+		"ninther":         sort.PivotNinther,
+	}
+
+	for name, strategy := range strategies {
+		for _, test := range intSortCases() {
+			t.Run(fmt.Sprintf("%s/%s", name, test.testName), func(t *testing.T) {
+				data := slices.Clone(test.data)
+				expected := slices.Clone(test.data)
+				slices.Sort(expected)
+
+				sort.QuickSortPivot(data, func(a, b int) bool { return a < b }, strategy)
+
+				assert.Equal(t, expected, data)
+			})
+		}
+	}
+}
+
+func TestQuickSortFunc_Descending(t *testing.T) {
+	data := randomInts(1000, 100, 5)
+	expected := slices.Clone(data)
+	slices.Sort(expected)
+	slices.Reverse(expected)
+
+	sort.QuickSortFunc(data, func(a, b int) bool { return a > b })
+
+	assert.Equal(t, expected, data)
+}
+
+func TestQuickSortFunc_RecordsByKey(t *testing.T) {
+	data := make([]record, 1000)
+	for i, key := range randomInts(len(data), 10, 6) {
+		data[i] = record{key: key, seq: i}
+	}
+
+	sort.QuickSortFunc(data, func(a, b record) bool { return a.key < b.key })
+
+	assert.True(t, slices.IsSortedFunc(data, func(a, b record) int { return a.key - b.key }))
+}
+
+func TestQuickSort_StringsAndFloats(t *testing.T) {
+	words := []string{"pear", "apple", "fig", "banana", "apple", "cherry"}
+	sort.QuickSort(words)
+	assert.Equal(t, []string{"apple", "apple", "banana", "cherry", "fig", "pear"}, words)
+
+	floats := []float64{3.5, math.NaN(), -1, math.Inf(1), 0, math.Inf(-1)}
+	sort.QuickSort(floats)
+	assert.True(t, math.IsNaN(floats[0]))
+	assert.Equal(t, []float64{math.Inf(-1), -1, 0, 3.5, math.Inf(1)}, floats[1:])
+}
+
+func TestQuickSort_AdversarialInputStaysLinearithmic(t *testing.T) {
+	const n = 1 << 14
+
+	inputs := map[string][]int{
+		"median-of-three killer": sort.MedianOfThreeKiller(n),
+		"organ pipe":             append(sort.Ascending(n/2), sort.Descending(n/2)...),
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			comparisons := 0
+			sort.QuickSortPivot(input, func(a, b int) bool {
+				comparisons++
+				return a < b
+			}, sort.PivotMedianOfThree)
+
+			assert.True(t, slices.IsSorted(input))
+			assert.Less(t, comparisons, 8*n*14)
+		})
+	}
+}
+
+func BenchmarkQuickSort(b *testing.B) {
+	for _, n := range []int{1_000, 100_000, 1_000_000} {
+		input := randomInts(n, n, 7)
+		data := make([]int, n)
+
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				sort.QuickSort(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package sort_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMSDRadixSort struct {
+	testName string
+	data     []string
+}
+
+// randomWords returns n pseudo-random lowercase words of up to maxLen letters drawn from alphabet.
+func randomWords(n, maxLen int, alphabet string, seed int64) []string {
+	rng := rand.New(rand.NewSource(seed))
+	words := make([]string, n)
+
+	for i := range words {
+		var word strings.Builder
+		for j := rng.Intn(maxLen + 1); j > 0; j-- {
+			word.WriteByte(alphabet[rng.Intn(len(alphabet))])
+		}
+
+		words[i] = word.String()
+	}
+
+	return words
+}
+
+func TestRadixSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.RadixSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestRadixSort_IntegerWidths(t *testing.T) {
+	rng := rand.New(rand.NewSource(27))
+
+	int8s := []int8{127, -128, 0, -1, 1, -128, 127, 5}
+	sort.RadixSort(int8s)
+	assert.Equal(t, []int8{-128, -128, -1, 0, 1, 5, 127, 127}, int8s)
+
+	int16s := make([]int16, 1000)
+	for i := range int16s {
+		int16s[i] = int16(rng.Uint32())
+	}
+	expected16 := slices.Clone(int16s)
+	slices.Sort(expected16)
+	sort.RadixSort(int16s)
+	assert.Equal(t, expected16, int16s)
+
+	int32s := []int32{math.MaxInt32, math.MinInt32, -7, 7, 0}
+	sort.RadixSort(int32s)
+	assert.Equal(t, []int32{math.MinInt32, -7, 0, 7, math.MaxInt32}, int32s)
+
+	int64s := make([]int64, 1000)
+	for i := range int64s {
+		int64s[i] = int64(rng.Uint64())
+	}
+	int64s = append(int64s, math.MinInt64, math.MaxInt64, -1, 0)
+	expected64 := slices.Clone(int64s)
+	slices.Sort(expected64)
+	sort.RadixSort(int64s)
+	assert.Equal(t, expected64, int64s)
+
+	uint64s := []uint64{math.MaxUint64, 0, 1 << 63, 1<<63 - 1, 42}
+	sort.RadixSort(uint64s)
+	assert.Equal(t, []uint64{0, 42, 1<<63 - 1, 1 << 63, math.MaxUint64}, uint64s)
+
+	uintptrs := []uintptr{3, 1, 2}
+	sort.RadixSort(uintptrs)
+	assert.Equal(t, []uintptr{1, 2, 3}, uintptrs)
+}
+
+func TestRadixSortBy_IsStable(t *testing.T) {
+	data := randomRecords(20_000, 1000, 28)
+	for i := range data {
+		data[i].key -= 500
+	}
+
+	sort.RadixSortBy(data, func(r record) int { return r.key })
+
+	assertStablySorted(t, data)
+}
+
+func TestMSDRadixSort(t *testing.T) {
+	tests := []testMSDRadixSort{
+		{testName: "Empty slice", data: []string{}},
+		{testName: "Single word", data: []string{"go"}},
+		{testName: "Prefixes and empty strings", data: []string{"abc", "", "ab", "a", "abcd", "", "b", "ab"}},
+		{testName: "Shared long prefixes", data: []string{"prefix-zeta", "prefix-alpha", "prefix-", "prefix-beta", "prefix-alphabet"}},
+		{testName: "Bytes above ASCII", data: []string{"\xff", "\x00", "я", "z", "\x80a", "\x80"}},
+		{testName: "Random short words", data: randomWords(5000, 6, "abc", 29)},
+		{testName: "Random long words", data: randomWords(3000, 40, "abcdefghijklmnopqrstuvwxyz", 30)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.MSDRadixSort(data)
+			assert.Equal(t, expected, data)
+
+			byteSlices := make([][]byte, len(test.data))
+			for i, word := range test.data {
+				byteSlices[i] = []byte(word)
+			}
+
+			sort.MSDRadixSort(byteSlices)
+			for i, word := range byteSlices {
+				assert.Equal(t, expected[i], string(word))
+			}
+		})
+	}
+}
+
+func TestMSDRadixSortBy_IsStable(t *testing.T) {
+	type user struct {
+		name string
+		seq  int
+	}
+
+	names := randomWords(5000, 4, "ab", 31)
+	data := make([]user, len(names))
+	for i, name := range names {
+		data[i] = user{name: name, seq: i}
+	}
+
+	sort.MSDRadixSortBy(data, func(u user) string { return u.name })
+
+	for i := 1; i < len(data); i++ {
+		assert.LessOrEqual(t, data[i-1].name, data[i].name)
+		if data[i-1].name == data[i].name {
+			assert.Less(t, data[i-1].seq, data[i].seq)
+		}
+	}
+}
+
+const benchIntegerSortSize = 10_000_000
+
+func BenchmarkIntegerSorts(b *testing.B) {
+	input := randomInts(benchIntegerSortSize, benchIntegerSortSize, 32)
+	data := make([]int, len(input))
+
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{name: "CountingSort", sort: func(data []int) { _ = sort.CountingSort(data) }},
+		{name: "RadixSort", sort: sort.RadixSort[int]},
+		{name: "QuickSort", sort: sort.QuickSort[int]},
+		{name: "slices.Sort", sort: slices.Sort[[]int]},
+	}
+
+	for _, algorithm := range sorts {
+		b.Run(fmt.Sprintf("%s/n=%d", algorithm.name, len(input)), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				algorithm.sort(data)
+			}
+		})
+	}
+}
+
+func BenchmarkRadixSort_FullRangeInt64(b *testing.B) {
+	rng := rand.New(rand.NewSource(33))
+	input := make([]int64, benchIntegerSortSize)
+	for i := range input {
+		input[i] = int64(rng.Uint64())
+	}
+	data := make([]int64, len(input))
+
+	b.Run("RadixSort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.RadixSort(data)
+		}
+	})
+
+	b.Run("slices.Sort", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			slices.Sort(data)
+		}
+	})
+}
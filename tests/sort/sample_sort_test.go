@@ -0,0 +1,246 @@
+package sort_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleSort(t *testing.T) {
+	cases := append(intSortCases(),
+		testSortInts{testName: "Large random", data: randomInts(100_000, 1<<30, 70)},
+		testSortInts{testName: "Large few unique", data: randomInts(100_000, 3, 71)},
+		testSortInts{testName: "Large reversed", data: sort.Descending(50_000)},
+		testSortInts{testName: "Large median-of-three killer", data: sort.MedianOfThreeKiller(50_000)},
+	)
+
+	for _, workers := range []int{1, 2, 3, 8} {
+		for _, test := range cases {
+			t.Run(fmt.Sprintf("workers=%d/%s", workers, test.testName), func(t *testing.T) {
+				data := slices.Clone(test.data)
+				expected := slices.Clone(test.data)
+				slices.Sort(expected)
+
+				sort.SampleSortParallel(data, func(a, b int) bool { return a < b }, workers)
+
+				assert.Equal(t, expected, data)
+			})
+		}
+	}
+
+	data := randomInts(20_000, 1000, 72)
+	expected := slices.Clone(data)
+	slices.Sort(expected)
+
+	sort.SampleSort(data)
+
+	assert.Equal(t, expected, data)
+}
+
+func TestSampleSortParallel_Deterministic(t *testing.T) {
+	input := randomRecords(60_000, 50, 73)
+	expected := slices.Clone(input)
+	slices.SortStableFunc(expected, func(a, b record) int { return a.key - b.key })
+
+	for _, workers := range []int{0, 2, 5, 16} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			data := slices.Clone(input)
+
+			sort.SampleSortParallel(data, recordLess, workers)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+
+	data := slices.Clone(input)
+	sort.SampleSortFunc(data, recordLess)
+	assert.Equal(t, expected, data)
+}
+
+type testSortingNetwork struct {
+	testName            string
+	build               func(n int) (*sort.SortingNetwork, error)
+	n                   int
+	expectedComparators int
+	expectedDepth       int
+}
+
+func TestSortingNetwork_Shape(t *testing.T) {
+	tests := []testSortingNetwork{
+		{testName: "Bitonic 0", build: sort.BitonicNetwork, n: 0},
+		{testName: "Bitonic 1", build: sort.BitonicNetwork, n: 1},
+		{testName: "Bitonic 2", build: sort.BitonicNetwork, n: 2, expectedComparators: 1, expectedDepth: 1},
+		{testName: "Bitonic 4", build: sort.BitonicNetwork, n: 4, expectedComparators: 6, expectedDepth: 3},
+		{testName: "Bitonic 8", build: sort.BitonicNetwork, n: 8, expectedComparators: 24, expectedDepth: 6},
+		{testName: "Bitonic 16", build: sort.BitonicNetwork, n: 16, expectedComparators: 80, expectedDepth: 10},
+		{testName: "Odd-even merge 4", build: sort.OddEvenMergeNetwork, n: 4, expectedComparators: 5, expectedDepth: 3},
+		{testName: "Odd-even merge 8", build: sort.OddEvenMergeNetwork, n: 8, expectedComparators: 19, expectedDepth: 6},
+		{testName: "Odd-even merge 16", build: sort.OddEvenMergeNetwork, n: 16, expectedComparators: 63, expectedDepth: 10},
+		{testName: "Odd-even merge 32", build: sort.OddEvenMergeNetwork, n: 32, expectedComparators: 191, expectedDepth: 15},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			network, err := test.build(test.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.n, network.Size)
+			assert.Equal(t, test.expectedComparators, network.Comparators())
+			assert.Equal(t, test.expectedDepth, network.Depth())
+
+			for _, layer := range network.Layers {
+				used := make(map[int]bool)
+				for _, c := range layer {
+					assert.Less(t, c.I, c.J)
+					assert.False(t, used[c.I] || used[c.J], "layer reuses an index")
+					used[c.I], used[c.J] = true, true
+				}
+			}
+		})
+	}
+}
+
+// TestSortingNetwork_ZeroOne checks every network up to 16 inputs on all 0-1 inputs, which by
+// the 0-1 principle proves that it sorts every input of its size.
+func TestSortingNetwork_ZeroOne(t *testing.T) {
+	builders := map[string]func(n int) (*sort.SortingNetwork, error){
+		"bitonic":        sort.BitonicNetwork,
+		"odd-even merge": sort.OddEvenMergeNetwork,
+	}
+
+	for name, build := range builders {
+		for n := 0; n <= 16; n++ {
+			t.Run(fmt.Sprintf("%s/%d", name, n), func(t *testing.T) {
+				network, err := build(n)
+				assert.NoError(t, err)
+
+				data := make([]int, n)
+				for mask := 0; mask < 1<<n; mask++ {
+					ones := 0
+					for i := range data {
+						data[i] = mask >> i & 1
+						ones += data[i]
+					}
+
+					assert.NoError(t, sort.SortWithNetwork(network, data))
+
+					if !slices.IsSorted(data) {
+						assert.Failf(t, "not sorted", "input mask %b gave %v", mask, data)
+						return
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSortingNetwork_Values(t *testing.T) {
+	for _, n := range []int{3, 13, 100, 1000} {
+		network, err := sort.OddEvenMergeNetwork(n)
+		assert.NoError(t, err)
+
+		bitonic, err := sort.BitonicNetwork(n)
+		assert.NoError(t, err)
+
+		data := randomInts(n, 50, int64(n))
+		expected := slices.Clone(data)
+		slices.Sort(expected)
+
+		other := slices.Clone(data)
+
+		assert.NoError(t, sort.SortWithNetworkFunc(network, data, func(a, b int) bool { return a < b }))
+		assert.NoError(t, sort.SortWithNetwork(bitonic, other))
+		assert.Equal(t, expected, data)
+		assert.Equal(t, expected, other)
+	}
+}
+
+func TestSortingNetwork_Errors(t *testing.T) {
+	_, err := sort.BitonicNetwork(-1)
+	assert.ErrorIs(t, err, sort.ErrNetworkSize)
+
+	_, err = sort.OddEvenMergeNetwork(sort.MaxNetworkSize + 1)
+	assert.ErrorIs(t, err, sort.ErrNetworkSize)
+
+	network, _ := sort.BitonicNetwork(4)
+	assert.EqualError(t, sort.SortWithNetwork(network, []int{3, 2, 1}), "invalid sorting network size: network sorts 4 inputs, got 3")
+}
+
+func BenchmarkSortingNetwork(b *testing.B) {
+	input := randomInts(16, 1000, 74)
+	less := func(a, b int) bool { return a < b }
+	bitonic, _ := sort.BitonicNetwork(len(input))
+	oddEven, _ := sort.OddEvenMergeNetwork(len(input))
+
+	benchmarks := map[string]func(data []int){
+		"Bitonic":       func(data []int) { _ = sort.SortWithNetworkFunc(bitonic, data, less) },
+		"OddEvenMerge":  func(data []int) { _ = sort.SortWithNetworkFunc(oddEven, data, less) },
+		"InsertionSort": func(data []int) { sort.InsertionSortFunc(data, less) },
+	}
+
+	for name, run := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			data := make([]int, len(input))
+
+			for i := 0; i < b.N; i++ {
+				copy(data, input)
+				run(data)
+			}
+		})
+	}
+}
+
+// BenchmarkParallelSorts compares the parallel sorts with the serial sorts of the package on the same
+// input; the ns/elem metric makes the results comparable across input sizes. The parallel sorts only
+// pull ahead when GOMAXPROCS allows several of their workers to run at once, e.g. with -cpu 1,4,8.
+// Recorded results are in pkg/algorithms/sort/BENCHMARKS.md.
+func BenchmarkParallelSorts(b *testing.B) {
+	less := func(a, b int) bool { return a < b }
+
+	type benchmark struct {
+		name string
+		run  func(data []int)
+	}
+
+	benchmarks := []benchmark{
+		{"QuickSortFunc", func(data []int) { sort.QuickSortFunc(data, less) }},
+		{"PDQSortFunc", func(data []int) { sort.PDQSortFunc(data, less) }},
+		{"MergeSortFunc", func(data []int) { sort.MergeSortFunc(data, less) }},
+		{"TimSortFunc", func(data []int) { sort.TimSortFunc(data, less) }},
+		{"slices.Sort", func(data []int) { slices.Sort(data) }},
+	}
+
+	for _, workers := range []int{2, 4, 8} {
+		benchmarks = append(benchmarks,
+			benchmark{fmt.Sprintf("MergeSortParallel/workers=%d", workers), func(data []int) {
+				sort.MergeSortParallel(data, less, workers)
+			}},
+			benchmark{fmt.Sprintf("SampleSortParallel/workers=%d", workers), func(data []int) {
+				sort.SampleSortParallel(data, less, workers)
+			}},
+		)
+	}
+
+	for _, n := range []int{1 << 16, 1 << 20} {
+		input := randomInts(n, 1<<30, 75)
+
+		for _, bench := range benchmarks {
+			b.Run(fmt.Sprintf("n=%d/%s", n, bench.name), func(b *testing.B) {
+				data := make([]int, n)
+
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					copy(data, input)
+					b.StartTimer()
+
+					bench.run(data)
+				}
+
+				b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+			})
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package sort_test
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPercentile struct {
+	testName string
+	data     []float64
+	p        float64
+	expected float64
+}
+
+// assertNthElement checks that data[k] holds the k-th smallest element of the original slice and that
+// data is partitioned around it.
+func assertNthElement(t *testing.T, original, data []int, k int) bool {
+	t.Helper()
+
+	expected := slices.Clone(original)
+	slices.Sort(expected)
+
+	ok := assert.Equal(t, expected[k], data[k])
+	for i, v := range data {
+		if i < k {
+			ok = ok && assert.LessOrEqual(t, v, data[k], "index %d", i)
+		} else if i > k {
+			ok = ok && assert.GreaterOrEqual(t, v, data[k], "index %d", i)
+		}
+	}
+
+	rest := slices.Clone(data)
+	slices.Sort(rest)
+
+	return ok && assert.Equal(t, expected, rest)
+}
+
+func TestNthElement(t *testing.T) {
+	for _, test := range intSortCases() {
+		if len(test.data) == 0 {
+			continue
+		}
+
+		for _, k := range []int{0, len(test.data) / 3, len(test.data) / 2, len(test.data) - 1} {
+			t.Run(fmt.Sprintf("%s/k=%d", test.testName, k), func(t *testing.T) {
+				data := slices.Clone(test.data)
+
+				assert.NoError(t, sort.NthElement(data, k))
+				assertNthElement(t, test.data, data, k)
+			})
+		}
+	}
+}
+
+func TestNthElement_Adversarial(t *testing.T) {
+	inputs := map[string][]int{
+		"median-of-three killer": sort.MedianOfThreeKiller(10_000),
+		"organ pipe":             append(sort.Ascending(5000), sort.Descending(5000)...),
+		"sawtooth":               randomInts(10_000, 1, 6),
+	}
+
+	for name, input := range inputs {
+		for _, k := range []int{0, 1234, len(input) / 2, len(input) - 1} {
+			t.Run(fmt.Sprintf("%s/k=%d", name, k), func(t *testing.T) {
+				data := slices.Clone(input)
+				assert.NoError(t, sort.NthElementFunc(data, k, func(a, b int) bool { return a < b }))
+				assertNthElement(t, input, data, k)
+
+				data = slices.Clone(input)
+				assert.NoError(t, sort.NthElementMedianOfMedians(data, k, func(a, b int) bool { return a < b }))
+				assertNthElement(t, input, data, k)
+			})
+		}
+	}
+}
+
+// quicksortAdversary returns McIlroy's adversary for quicksort-like algorithms: the values of data are
+// decided lazily by less, so that every pivot turns out to be as bad as possible, and comparisons counts
+// the calls of less.
+func quicksortAdversary(n int, comparisons *int) ([]int, func(a, b int) bool) {
+	gas := n
+	values := make([]int, n)
+	data := make([]int, n)
+
+	for i := range data {
+		data[i], values[i] = i, gas
+	}
+
+	solid, candidate := 0, 0
+	freeze := func(x int) {
+		values[x] = solid
+		solid++
+	}
+
+	return data, func(a, b int) bool {
+		*comparisons++
+
+		if values[a] == gas && values[b] == gas {
+			if a == candidate {
+				freeze(a)
+			} else {
+				freeze(b)
+			}
+		}
+
+		if values[a] == gas {
+			candidate = a
+		} else if values[b] == gas {
+			candidate = b
+		}
+
+		return values[a] < values[b]
+	}
+}
+
+func TestNthElementFunc_LinearAgainstAdversary(t *testing.T) {
+	for _, n := range []int{10_000, 100_000, 1_000_000} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			comparisons := 0
+			data, less := quicksortAdversary(n, &comparisons)
+
+			assert.NoError(t, sort.NthElementFunc(data, n/2, less))
+			assert.Less(t, comparisons, 20*n)
+		})
+	}
+}
+
+func TestNthElement_Property(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	property := func(data []int16, rank uint16, deterministic bool) bool {
+		if len(data) == 0 {
+			return true
+		}
+
+		original := make([]int, len(data))
+		for i, v := range data {
+			original[i] = int(v % 50)
+		}
+
+		values := slices.Clone(original)
+		k := int(rank) % len(values)
+
+		if deterministic {
+			assert.NoError(t, sort.NthElementMedianOfMedians(values, k, less))
+		} else {
+			assert.NoError(t, sort.NthElementFunc(values, k, less))
+		}
+
+		return assertNthElement(t, original, values, k)
+	}
+
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 500}))
+}
+
+func TestSelect_Property(t *testing.T) {
+	property := func(data []float64, rank uint16) bool {
+		if len(data) == 0 {
+			return true
+		}
+
+		original := slices.Clone(data)
+		k := int(rank) % len(data)
+
+		selected, err := sort.Select(data, k)
+
+		expected := slices.Clone(data)
+		slices.Sort(expected)
+
+		return err == nil && selected == expected[k] && slices.Equal(original, data)
+	}
+
+	assert.NoError(t, quick.Check(property, nil))
+}
+
+func TestSelectFunc_Records(t *testing.T) {
+	data := randomRecords(1000, 100, 31)
+	expected := slices.Clone(data)
+	slices.SortStableFunc(expected, func(a, b record) int { return a.key - b.key })
+
+	for _, k := range []int{0, 10, 500, 999} {
+		selected, err := sort.SelectFunc(data, k, recordLess)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected[k].key, selected.key)
+	}
+}
+
+func TestSelect_Errors(t *testing.T) {
+	_, err := sort.Select([]int{}, 0)
+	assert.ErrorIs(t, err, sort.ErrIndexOutOfRange)
+
+	data := []int{3, 1, 2}
+	_, err = sort.Select(data, 3)
+	assert.ErrorIs(t, err, sort.ErrIndexOutOfRange)
+
+	assert.ErrorIs(t, sort.NthElement(data, -1), sort.ErrIndexOutOfRange)
+	assert.EqualError(t, sort.NthElement(data, 5), "index is out of range: rank 5, length 3")
+	assert.Equal(t, []int{3, 1, 2}, data)
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []testPercentile{
+		{testName: "Single value", data: []float64{7}, p: 90, expected: 7},
+		{testName: "Minimum", data: []float64{3, 1, 2}, p: 0, expected: 1},
+		{testName: "Maximum", data: []float64{3, 1, 2}, p: 100, expected: 3},
+		{testName: "Odd median", data: []float64{5, 1, 3}, p: 50, expected: 3},
+		{testName: "Even median is interpolated", data: []float64{4, 1, 3, 2}, p: 50, expected: 2.5},
+		{testName: "Quartile between ranks", data: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, p: 25, expected: 3.25},
+		{testName: "Ninetieth percentile", data: []float64{15, 20, 35, 40, 50}, p: 90, expected: 46},
+		{testName: "Equal neighbours", data: []float64{2, 2, 2, 9}, p: 40, expected: 2},
+		{testName: "Infinite values", data: []float64{math.Inf(1), 1, math.Inf(1)}, p: 75, expected: math.Inf(1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			original := slices.Clone(test.data)
+
+			value, err := sort.Percentile(test.data, test.p)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+			assert.Equal(t, original, test.data)
+		})
+	}
+}
+
+func TestPercentile_Integers(t *testing.T) {
+	median, err := sort.Median([]int{10, 1, 7, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 5.5, median)
+
+	values, err := sort.Percentiles([]uint8{200, 0, 100, 255, 50}, 10, 50, 99, 50)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{20, 100, 252.8, 100}, values, 1e-9)
+}
+
+func TestPercentiles_Property(t *testing.T) {
+	property := func(data []int32, p1, p2, p3 uint8) bool {
+		if len(data) == 0 {
+			return true
+		}
+
+		ps := []float64{float64(p1 % 101), float64(p2%101) / 3, 100 - float64(p3%101)/7}
+
+		values, err := sort.Percentiles(data, ps...)
+		if err != nil {
+			return false
+		}
+
+		sorted := slices.Clone(data)
+		slices.Sort(sorted)
+
+		for i, p := range ps {
+			rank := p / 100 * float64(len(data)-1)
+			lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+			expected := float64(sorted[lo]) + (float64(sorted[hi])-float64(sorted[lo]))*(rank-math.Floor(rank))
+
+			if !assert.InDelta(t, expected, values[i], 1e-6*math.Max(1, math.Abs(expected))) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	assert.NoError(t, quick.Check(property, &quick.Config{MaxCount: 300}))
+}
+
+func TestPercentile_Errors(t *testing.T) {
+	_, err := sort.Median([]float64{})
+	assert.ErrorIs(t, err, sort.ErrEmptyInput)
+
+	for _, p := range []float64{-1, 100.5, math.NaN()} {
+		_, err := sort.Percentile([]int{1, 2, 3}, p)
+		assert.ErrorIs(t, err, sort.ErrInvalidPercentile)
+	}
+}
+
+func BenchmarkSelectMedian(b *testing.B) {
+	input := randomInts(1<<20, 1<<30, 32)
+	less := func(a, b int) bool { return a < b }
+	k := len(input) / 2
+
+	benchmarks := map[string]func(data []int){
+		"NthElement":                func(data []int) { _ = sort.NthElement(data, k) },
+		"NthElementMedianOfMedians": func(data []int) { _ = sort.NthElementMedianOfMedians(data, k, less) },
+		"slices.Sort":               func(data []int) { slices.Sort(data) },
+	}
+
+	for name, run := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			data := make([]int, len(input))
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(data, input)
+				b.StartTimer()
+
+				run(data)
+			}
+		})
+	}
+}
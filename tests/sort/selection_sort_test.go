@@ -0,0 +1,30 @@
+package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectionSortFunc_Pointers(t *testing.T) {
+	const n = 500
+
+	data := make([]*int, n)
+	for i, value := range randomInts(n, n, 19) {
+		data[i] = &value
+	}
+
+	sort.SelectionSortFunc(data, func(a, b *int) bool { return *a < *b })
+
+	assert.True(t, slices.IsSortedFunc(data, func(a, b *int) int { return *a - *b }))
+}
+
+func TestSelectionSort_Descending(t *testing.T) {
+	data := []float64{2.5, -1, 7, 0, 7, 3.25}
+
+	sort.SelectionSortFunc(data, func(a, b float64) bool { return a > b })
+
+	assert.Equal(t, []float64{7, 7, 3.25, 2.5, 0, -1}, data)
+}
@@ -0,0 +1,102 @@
+package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimSort(t *testing.T) {
+	for _, test := range intSortCases() {
+		t.Run(test.testName, func(t *testing.T) {
+			data := slices.Clone(test.data)
+			expected := slices.Clone(test.data)
+			slices.Sort(expected)
+
+			sort.TimSort(data)
+
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestTimSort_Distributions(t *testing.T) {
+	for _, shape := range distributions() {
+		for _, n := range []int{63, 64, 65, 1000, 100_000} {
+			data := shape.generate(n)
+			expected := slices.Clone(data)
+			slices.Sort(expected)
+
+			sort.TimSort(data)
+
+			assert.Equal(t, expected, data, "%s/n=%d", shape.name, n)
+		}
+	}
+}
+
+func TestTimSortFunc_IsStable(t *testing.T) {
+	inputs := map[string][]record{
+		"random few keys":  randomRecords(100_000, 20, 39),
+		"random many keys": randomRecords(100_000, 50_000, 40),
+		// Long runs of equal keys make the merges gallop.
+		"blocks": func() []record {
+			data := make([]record, 60_000)
+			for i := range data {
+				data[i] = record{key: (i / 5000) % 4, seq: i}
+			}
+
+			return data
+		}(),
+		// Descending runs with equal keys must not be reversed as a whole.
+		"descending pairs": func() []record {
+			data := make([]record, 10_000)
+			for i := range data {
+				data[i] = record{key: (len(data) - i) / 2, seq: i}
+			}
+
+			return data
+		}(),
+	}
+
+	for name, data := range inputs {
+		t.Run(name, func(t *testing.T) {
+			sort.TimSortFunc(data, recordLess)
+
+			assertStablySorted(t, data)
+		})
+	}
+}
+
+func TestTimSort_GallopingSavesComparisons(t *testing.T) {
+	const n = 1 << 16
+
+	// Two sorted halves whose values interleave in long blocks.
+	data := make([]int, 0, n)
+	for half := 0; half < 2; half++ {
+		for i := 0; i < n/2; i++ {
+			block, offset := i/1024, i%1024
+			data = append(data, (2*block+half)*1024+offset)
+		}
+	}
+
+	counter := &sort.Counter[int]{}
+	assert.NoError(t, sort.SortTraced(sort.AlgorithmTim, data, func(a, b int) bool { return a < b }, counter))
+
+	// Detecting the two runs takes n-1 comparisons; a merge without galloping would take about n more.
+	assert.True(t, slices.IsSorted(data))
+	assert.Less(t, counter.Comparisons, n+n/16)
+}
+
+func TestTimSort_SortedAndReversedAreLinear(t *testing.T) {
+	const n = 1 << 16
+
+	for name, data := range map[string][]int{"sorted": sort.Ascending(n), "reversed": sort.Descending(n)} {
+		counter := &sort.Counter[int]{}
+		assert.NoError(t, sort.SortTraced(sort.AlgorithmTim, data, func(a, b int) bool { return a < b }, counter))
+
+		assert.True(t, slices.IsSorted(data), name)
+		assert.Equal(t, n-1, counter.Comparisons, name)
+	}
+}
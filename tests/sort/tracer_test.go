@@ -0,0 +1,229 @@
+package sort_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testTracerCounts struct {
+	testName  string
+	algorithm sort.Algorithm
+	data      []int
+	expected  sort.Counter[int]
+}
+
+func TestSortTraced_ReplayReproducesSort(t *testing.T) {
+	inputs := map[string][]int{
+		"random":    randomInts(400, 100, 20),
+		"reversed":  sort.Descending(200),
+		"few-equal": randomInts(300, 3, 21),
+	}
+
+	for _, algorithm := range sort.Algorithms() {
+		for name, input := range inputs {
+			t.Run(algorithm.String()+"/"+name, func(t *testing.T) {
+				data := slices.Clone(input)
+				recorder := &sort.Recorder[int]{}
+
+				lessCalls := 0
+				err := sort.SortTraced(algorithm, data, func(a, b int) bool {
+					lessCalls++
+					return a < b
+				}, recorder)
+
+				assert.NoError(t, err)
+				assert.True(t, slices.IsSorted(data))
+				assert.Equal(t, data, recorder.Replay(input, nil))
+				assert.Equal(t, lessCalls, recorder.Counts().Comparisons)
+
+				counter := &sort.Counter[int]{}
+				assert.NoError(t, sort.SortTraced(algorithm, slices.Clone(input), func(a, b int) bool { return a < b }, counter))
+				assert.Equal(t, recorder.Counts(), *counter)
+			})
+		}
+	}
+}
+
+func TestSortTraced_Counts(t *testing.T) {
+	tests := []testTracerCounts{
+		{
+			testName:  "Bubble sort of reversed input swaps every pair",
+			algorithm: sort.AlgorithmBubble,
+			data:      []int{5, 4, 3, 2, 1},
+			expected:  sort.Counter[int]{Comparisons: 10, Swaps: 10},
+		},
+		{
+			testName:  "Bubble sort of sorted input makes one pass",
+			algorithm: sort.AlgorithmBubble,
+			data:      []int{1, 2, 3, 4, 5},
+			expected:  sort.Counter[int]{Comparisons: 4},
+		},
+		{
+			testName:  "Selection sort of sorted input never swaps",
+			algorithm: sort.AlgorithmSelection,
+			data:      []int{1, 2, 3, 4, 5},
+			expected:  sort.Counter[int]{Comparisons: 10},
+		},
+		{
+			testName:  "Insertion sort of reversed input",
+			algorithm: sort.AlgorithmInsertion,
+			data:      []int{4, 3, 2, 1},
+			expected:  sort.Counter[int]{Comparisons: 6, Swaps: 6},
+		},
+		{
+			testName:  "Binary insertion writes instead of swapping",
+			algorithm: sort.AlgorithmBinaryInsertion,
+			data:      []int{3, 1, 2},
+			expected:  sort.Counter[int]{Comparisons: 3, Writes: 4},
+		},
+		{
+			testName:  "Merge sort of sorted input only compares run boundaries",
+			algorithm: sort.AlgorithmMerge,
+			data:      sort.Ascending(32),
+			expected:  sort.Counter[int]{Comparisons: 4*7 + 3, Partitions: 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			counter := &sort.Counter[int]{}
+
+			err := sort.SortTraced(test.algorithm, test.data, func(a, b int) bool { return a < b }, counter)
+
+			assert.NoError(t, err)
+			assert.True(t, slices.IsSorted(test.data))
+			assert.Equal(t, test.expected, *counter)
+		})
+	}
+}
+
+func TestSortTraced_QuickSortPartitions(t *testing.T) {
+	data := randomInts(1000, 50, 22)
+	recorder := &sort.Recorder[int]{}
+
+	assert.NoError(t, sort.SortTraced(sort.AlgorithmQuick, data, func(a, b int) bool { return a < b }, recorder))
+
+	partitions := 0
+	recorder.Replay(randomInts(1000, 50, 22), func(_ int, event sort.Event[int], state []int) {
+		if event.Kind != sort.EventPartition {
+			return
+		}
+
+		partitions++
+		lo, lt, gt, hi := event.Bounds[0], event.Bounds[1], event.Bounds[2], event.Bounds[3]
+		assert.True(t, lo <= lt && lt < gt && gt <= hi)
+
+		for _, value := range state[lo:lt] {
+			assert.Less(t, value, state[lt])
+		}
+		for _, value := range state[lt:gt] {
+			assert.Equal(t, state[lt], value)
+		}
+		for _, value := range state[gt:hi] {
+			assert.Greater(t, value, state[lt])
+		}
+	})
+
+	assert.Positive(t, partitions)
+}
+
+func TestSortTraced_UnknownAlgorithm(t *testing.T) {
+	err := sort.SortTraced(sort.Algorithm(-1), []int{2, 1}, func(a, b int) bool { return a < b }, nil)
+
+	assert.ErrorIs(t, err, sort.ErrUnknownAlgorithm)
+	assert.EqualError(t, err, "unknown sort algorithm: Algorithm(-1)")
+}
+
+func TestSortTraced_NilTracer(t *testing.T) {
+	for _, algorithm := range sort.Algorithms() {
+		data := randomInts(100, 100, 23)
+
+		assert.NoError(t, sort.SortTraced(algorithm, data, func(a, b int) bool { return a < b }, nil))
+		assert.True(t, slices.IsSorted(data), algorithm.String())
+	}
+}
+
+func TestEvent_String(t *testing.T) {
+	recorder := &sort.Recorder[string]{}
+	assert.NoError(t, sort.SortTraced(sort.AlgorithmBinaryInsertion, []string{"b", "a"}, func(a, b string) bool { return a < b }, recorder))
+
+	var events []string
+	for _, event := range recorder.Events {
+		events = append(events, event.String())
+	}
+
+	assert.Equal(t, []string{"compare(1, 0)", "write(1, b)", "write(0, a)"}, events)
+	assert.Equal(t, "partition(0, 2, 3, 5)", sort.Event[int]{Kind: sort.EventPartition, Bounds: [4]int{0, 2, 3, 5}}.String())
+	assert.Equal(t, "swap(4, 1)", sort.Event[int]{Kind: sort.EventSwap, I: 4, J: 1}.String())
+}
+
+func TestMergeSorter_TracesParallelMode(t *testing.T) {
+	input := randomRecords(50_000, 100, 24)
+
+	counter := &sort.Counter[record]{}
+	serial := sort.NewMergeSorter(recordLess, sort.MergeTopDown)
+	serial.Tracer = counter
+	serial.Sort(slices.Clone(input))
+
+	recorder := &sort.Recorder[record]{}
+	sorter := sort.NewMergeSorter(recordLess, sort.MergeParallel)
+	sorter.Workers = 4
+	sorter.ParallelThreshold = 64
+	sorter.Tracer = recorder
+
+	data := slices.Clone(input)
+	sorter.Sort(data)
+
+	// The parallel sort splits the slice like the top-down one, so it does the same operations in another order.
+	assertStablySorted(t, data)
+	assert.True(t, slices.Equal(data, recorder.Replay(input, nil)))
+	assert.Equal(t, *counter, recorder.Counts())
+}
+
+func TestPartialSortTraced(t *testing.T) {
+	input := randomInts(500, 1000, 26)
+
+	for _, k := range []int{0, 1, 10, 250, 500} {
+		data := slices.Clone(input)
+		recorder := &sort.Recorder[int]{}
+		sort.PartialSortTraced(data, k, func(a, b int) bool { return a < b }, recorder)
+
+		expected := slices.Clone(input)
+		sort.PartialSort(expected, k)
+
+		assert.Equal(t, expected, data)
+		assert.Equal(t, data, recorder.Replay(input, nil))
+	}
+}
+
+func BenchmarkQuickSort_Tracing(b *testing.B) {
+	const n = 100_000
+
+	input := randomInts(n, n, 25)
+	data := make([]int, n)
+	less := func(a, b int) bool { return a < b }
+
+	b.Run("untraced", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			sort.QuickSortFunc(data, less)
+		}
+	})
+
+	b.Run("nil-tracer", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			_ = sort.SortTraced(sort.AlgorithmQuick, data, less, nil)
+		}
+	})
+
+	b.Run("counter", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(data, input)
+			_ = sort.SortTraced(sort.AlgorithmQuick, data, less, &sort.Counter[int]{})
+		}
+	})
+}
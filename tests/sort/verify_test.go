@@ -0,0 +1,175 @@
+package sort_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/k6zma/GoAlgoCraft/pkg/algorithms/sort"
+	"github.com/stretchr/testify/assert"
+)
+
+type testIsSorted struct {
+	testName      string
+	data          []float64
+	expected      bool
+	expectedIndex int
+}
+
+type testIsStableSorted struct {
+	testName string
+	original []record
+	sorted   []record
+	expected bool
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []testIsSorted{
+		{testName: "Nil slice", data: nil, expected: true, expectedIndex: -1},
+		{testName: "Single element", data: []float64{1}, expected: true, expectedIndex: -1},
+		{testName: "Ascending with duplicates", data: []float64{1, 1, 2, 3, 3}, expected: true, expectedIndex: -1},
+		{testName: "Descending pair", data: []float64{2, 1}, expected: false, expectedIndex: 1},
+		{testName: "Late inversion", data: []float64{1, 2, 3, 5, 4}, expected: false, expectedIndex: 4},
+		{testName: "NaN goes first", data: []float64{math.NaN(), math.Inf(-1), 0}, expected: true, expectedIndex: -1},
+		{testName: "NaN after a number", data: []float64{0, math.NaN()}, expected: false, expectedIndex: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expected, sort.IsSorted(test.data))
+			assert.Equal(t, test.expectedIndex, sort.UnsortedIndex(test.data, func(a, b float64) bool {
+				return a < b || (math.IsNaN(a) && !math.IsNaN(b))
+			}))
+		})
+	}
+
+	assert.True(t, sort.IsSortedFunc([]string{"c", "b", "a"}, func(a, b string) bool { return a > b }))
+}
+
+func TestIsStableSorted(t *testing.T) {
+	original := []record{{2, 0}, {1, 1}, {2, 2}, {1, 3}}
+
+	tests := []testIsStableSorted{
+		{testName: "Stable order", original: original, sorted: []record{{1, 1}, {1, 3}, {2, 0}, {2, 2}}, expected: true},
+		{testName: "Equal keys swapped", original: original, sorted: []record{{1, 3}, {1, 1}, {2, 0}, {2, 2}}, expected: false},
+		{testName: "Not sorted", original: original, sorted: []record{{2, 0}, {2, 2}, {1, 1}, {1, 3}}, expected: false},
+		{testName: "Element lost", original: original, sorted: []record{{1, 1}, {1, 1}, {2, 0}, {2, 2}}, expected: false},
+		{testName: "Different length", original: original, sorted: []record{{1, 1}, {1, 3}, {2, 0}}, expected: false},
+		{testName: "Empty", original: nil, sorted: []record{}, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expected, sort.IsStableSorted(test.original, test.sorted, recordLess))
+		})
+	}
+
+	pointers := []*record{{2, 0}, {1, 1}, {2, 2}}
+	sorted := []*record{pointers[1], pointers[0], pointers[2]}
+	assert.True(t, sort.IsStableSortedFunc(pointers, sorted, func(a, b *record) bool { return a.key < b.key },
+		func(a, b *record) bool { return a == b }))
+
+	sorted[1], sorted[2] = sorted[2], sorted[1]
+	assert.False(t, sort.IsStableSortedFunc(pointers, sorted, func(a, b *record) bool { return a.key < b.key },
+		func(a, b *record) bool { return a == b }))
+}
+
+func TestGenerators(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2, 3}, sort.Ascending(4))
+	assert.Equal(t, []int{3, 2, 1, 0}, sort.Descending(4))
+	assert.Equal(t, []int{0, 1, 2, 2, 1, 0}, sort.OrganPipe(6))
+	assert.Equal(t, []int{0, 1, 2, 1, 0}, sort.OrganPipe(5))
+	assert.Equal(t, []int{0, 1, 2, 0, 1, 2, 0}, sort.Sawtooth(7, 3))
+	assert.Equal(t, []int{1, 5, 3, 7, 2, 4, 6, 8}, sort.MedianOfThreeKiller(8))
+	assert.Len(t, sort.MedianOfThreeKiller(9), 9)
+
+	for _, v := range sort.FewUnique(1000, 3, 80) {
+		assert.True(t, v >= 0 && v < 3)
+	}
+
+	nearly := sort.NearlySorted(1000, 5, 81)
+	assert.False(t, slices.IsSorted(nearly))
+	slices.Sort(nearly)
+	assert.Equal(t, sort.Ascending(1000), nearly)
+}
+
+// naiveQuickSort is a median-of-three quicksort without an introsort fallback, the textbook
+// target of McIlroy's adversary.
+func naiveQuickSort(data []int, less func(a, b int) bool) {
+	if len(data) < 2 {
+		return
+	}
+
+	mid, last := len(data)/2, len(data)-1
+	if less(data[mid], data[0]) {
+		data[mid], data[0] = data[0], data[mid]
+	}
+	if less(data[last], data[mid]) {
+		data[last], data[mid] = data[mid], data[last]
+		if less(data[mid], data[0]) {
+			data[mid], data[0] = data[0], data[mid]
+		}
+	}
+
+	data[mid], data[last] = data[last], data[mid]
+
+	store := 0
+	for i := 0; i < last; i++ {
+		if less(data[i], data[last]) {
+			data[i], data[store] = data[store], data[i]
+			store++
+		}
+	}
+
+	data[store], data[last] = data[last], data[store]
+
+	naiveQuickSort(data[:store], less)
+	naiveQuickSort(data[store+1:], less)
+}
+
+// countComparisons sorts data with sortFunc and returns the number of comparisons it made.
+func countComparisons(data []int, sortFunc func(data []int, less func(a, b int) bool)) int {
+	comparisons := 0
+	sortFunc(data, func(a, b int) bool {
+		comparisons++
+		return a < b
+	})
+
+	return comparisons
+}
+
+func TestKillerAdversary(t *testing.T) {
+	const n = 2000
+
+	sorts := []struct {
+		name      string
+		sort      func(data []int, less func(a, b int) bool)
+		quadratic bool
+	}{
+		{name: "naive median-of-three quicksort", sort: naiveQuickSort, quadratic: true},
+		{name: "QuickSortFunc", sort: sort.QuickSortFunc[int]},
+		{name: "PDQSortFunc", sort: sort.PDQSortFunc[int]},
+		{name: "HeapSortFunc", sort: sort.HeapSortFunc[int]},
+		{name: "TimSortFunc", sort: sort.TimSortFunc[int]},
+	}
+
+	for _, test := range sorts {
+		t.Run(test.name, func(t *testing.T) {
+			killer := sort.KillerAdversary(n, test.sort)
+
+			data := slices.Clone(killer)
+			comparisons := countComparisons(data, test.sort)
+
+			assert.True(t, slices.IsSorted(data))
+
+			if test.quadratic {
+				assert.Greater(t, comparisons, n*n/8)
+				assert.Less(t, countComparisons(randomInts(n, n, 82), test.sort), 4*n*11)
+			} else {
+				// Introsort may still spend 2*log2(n) unbalanced partitions before falling back to
+				// heapsort, so the bound is a generous multiple of n*log2(n), far below n*n/8.
+				assert.Less(t, comparisons, 8*n*11)
+			}
+		})
+	}
+}